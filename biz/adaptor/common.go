@@ -51,10 +51,15 @@ func PostProcess(ctx context.Context, c *app.RequestContext, req, resp any, err
 	default:
 		// 处理错误响应
 		if code, ok := err.(consts.ErrorWithCode); ok {
-			c.JSON(hertz.StatusOK, ResponseData{
+			response := ResponseData{
 				Code: int64(code.ErrorCode()),
 				Msg:  code.Error(),
-			})
+			}
+			// 携带结构化错误详情时一并返回
+			if withData, ok := err.(consts.ErrorWithData); ok {
+				response.Data = withData.ErrorData()
+			}
+			c.JSON(hertz.StatusOK, response)
 		} else {
 			c.JSON(hertz.StatusInternalServerError, ResponseData{
 				Code: consts.ErrSystem,
@@ -4,11 +4,16 @@ package Practice
 
 import (
 	"auth/biz/adaptor"
+	"auth/biz/adaptor/middleware"
 	"auth/biz/application/dto/Auth/Practice"
 	"auth/biz/application/service"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
 	"context"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
 	hconsts "github.com/cloudwego/hertz/pkg/protocol/consts"
 )
 
@@ -66,8 +71,8 @@ func Register(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// 调用服务层注册用户
-	response, err := authService.Register(ctx, &req)
+	// 调用服务层注册用户，注册成功即创建登录会话
+	response, err := authService.Register(ctx, &req, c.ClientIP(), string(c.UserAgent()))
 
 	// 返回响应
 	adaptor.PostProcess(ctx, c, &req, response, err)
@@ -89,8 +94,11 @@ func Login(ctx context.Context, c *app.RequestContext) {
 	// TODO：IP是否真实
 	clientIP := c.ClientIP()
 
+	// 获取登录设备信息
+	userAgent := string(c.UserAgent())
+
 	// 调用服务层登录
-	response, err := authService.Login(ctx, &req, clientIP)
+	response, err := authService.Login(ctx, &req, clientIP, userAgent)
 
 	// 返回响应
 	adaptor.PostProcess(ctx, c, &req, response, err)
@@ -156,3 +164,820 @@ func KickUser(ctx context.Context, c *app.RequestContext) {
 	// 返回响应
 	adaptor.PostProcess(ctx, c, &req, response, err)
 }
+
+// RefreshToken 刷新令牌
+// @router /api/auth/refresh [POST]
+func RefreshToken(ctx context.Context, c *app.RequestContext) {
+	var req Practice.RefreshTokenReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层刷新令牌
+	response, err := authService.RefreshToken(ctx, &req)
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// Logout 退出登录
+// @router /api/auth/logout [POST]
+func Logout(ctx context.Context, c *app.RequestContext) {
+	var req Practice.LogoutReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.LogoutResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层退出登录
+	response, err := authService.Logout(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// LogoutAll 退出所有会话
+// @router /api/auth/logout-all [POST]
+func LogoutAll(ctx context.Context, c *app.RequestContext) {
+	var req Practice.LogoutAllReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.LogoutAllResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层退出所有会话
+	response, err := authService.LogoutAll(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ListSessions 获取登录会话列表
+// @router /api/auth/sessions [GET]
+func ListSessions(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ListSessionsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ListSessionsResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层获取会话列表
+	response, err := authService.ListSessions(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// RevokeSession 吊销登录会话
+// @router /api/auth/sessions/:id [DELETE]
+func RevokeSession(ctx context.Context, c *app.RequestContext) {
+	var req Practice.RevokeSessionReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.RevokeSessionResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 会话ID来自路径参数
+	req.Id = c.Param("id")
+
+	// 调用服务层吊销会话
+	response, err := authService.RevokeSession(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// Introspect 令牌自省（RFC 7662），供资源服务器和网关校验token是否仍然有效
+// @router /api/auth/introspect [POST]
+func Introspect(ctx context.Context, c *app.RequestContext) {
+	var req service.IntrospectReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层自省token
+	response, err := authService.Introspect(ctx, &req)
+
+	// 自省结果不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 无法确定token状态时返回503，调用方应按失败处理并重试，不能当作active=false
+	if err != nil {
+		code := consts.ErrSystem
+		if withCode, ok := err.(consts.ErrorWithCode); ok {
+			code = withCode.ErrorCode()
+		}
+		c.JSON(hconsts.StatusServiceUnavailable, &adaptor.ResponseData{
+			Code: int64(code),
+			Msg:  consts.ErrMsg[code],
+		})
+		return
+	}
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// CreateClient 注册OAuth客户端（管理员功能）
+// @router /api/auth/clients [POST]
+func CreateClient(ctx context.Context, c *app.RequestContext) {
+	var req Practice.CreateClientReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.CreateClientResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层注册客户端
+	response, err := authService.CreateClient(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ListClients 获取OAuth客户端列表（管理员功能）
+// @router /api/auth/clients [GET]
+func ListClients(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ListClientsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ListClientsResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层获取客户端列表
+	response, err := authService.ListClients(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// DeleteClient 删除OAuth客户端（管理员功能）
+// @router /api/auth/clients/:clientId [DELETE]
+func DeleteClient(ctx context.Context, c *app.RequestContext) {
+	var req Practice.DeleteClientReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.DeleteClientResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 客户端ID来自路径参数
+	req.ClientId = c.Param("clientId")
+
+	// 调用服务层删除客户端
+	response, err := authService.DeleteClient(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// CreateServiceAccount 创建服务账号（管理员功能）
+// @router /api/auth/service-accounts [POST]
+func CreateServiceAccount(ctx context.Context, c *app.RequestContext) {
+	var req Practice.CreateServiceAccountReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.CreateServiceAccountResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层创建服务账号
+	response, err := authService.CreateServiceAccount(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ListServiceAccounts 获取服务账号列表（管理员功能）
+// @router /api/auth/service-accounts [GET]
+func ListServiceAccounts(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ListServiceAccountsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ListServiceAccountsResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层获取服务账号列表
+	response, err := authService.ListServiceAccounts(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// RotateServiceAccountSecret 轮换服务账号密钥（管理员功能）
+// @router /api/auth/service-accounts/:clientId/rotate [POST]
+func RotateServiceAccountSecret(ctx context.Context, c *app.RequestContext) {
+	var req Practice.RotateServiceAccountSecretReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.RotateServiceAccountSecretResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 服务账号client_id来自路径参数
+	req.ClientId = c.Param("clientId")
+
+	// 调用服务层轮换密钥
+	response, err := authService.RotateServiceAccountSecret(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// SetServiceAccountStatus 启用或停用服务账号（管理员功能）
+// @router /api/auth/service-accounts/:clientId/status [PUT]
+func SetServiceAccountStatus(ctx context.Context, c *app.RequestContext) {
+	var req Practice.SetServiceAccountStatusReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.SetServiceAccountStatusResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 服务账号client_id来自路径参数
+	req.ClientId = c.Param("clientId")
+
+	// 调用服务层更新状态
+	response, err := authService.SetServiceAccountStatus(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ForgotPassword 忘记密码，发送重置密码验证码
+// @router /api/auth/password/forgot [POST]
+func ForgotPassword(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ForgotPasswordReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ForgotPasswordResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层发送重置密码验证码
+	response, err := authService.ForgotPassword(ctx, &req)
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ResetPassword 使用验证码重置密码
+// @router /api/auth/password/reset [POST]
+func ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ResetPasswordReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ResetPasswordResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层重置密码
+	response, err := authService.ResetPassword(ctx, &req)
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ChangePassword 修改当前用户密码
+// @router /api/auth/password/change [POST]
+func ChangePassword(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ChangePasswordReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ChangePasswordResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层修改密码
+	response, err := authService.ChangePassword(ctx, &req, middleware.GetCurrentToken(c), c.ClientIP())
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// SendLoginCode 发送登录验证码
+// @router /api/auth/login/code/send [POST]
+func SendLoginCode(ctx context.Context, c *app.RequestContext) {
+	var req Practice.SendLoginCodeReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.SendLoginCodeResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层发送登录验证码
+	response, err := authService.SendLoginCode(ctx, &req)
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// LoginWithCode 使用邮箱或手机号验证码登录
+// @router /api/auth/login/code [POST]
+func LoginWithCode(ctx context.Context, c *app.RequestContext) {
+	var req Practice.LoginWithCodeReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层验证码登录
+	response, err := authService.LoginWithCode(ctx, &req, c.ClientIP(), string(c.UserAgent()))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// SendMagicLink 发送登录链接，同时在当前浏览器设置nonce cookie，链接只能在该浏览器中使用
+// @router /api/auth/magic/send [POST]
+func SendMagicLink(ctx context.Context, c *app.RequestContext) {
+	var req Practice.SendMagicLinkReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.SendMagicLinkResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 生成绑定当前浏览器的nonce
+	nonce, err := util.GenerateMagicLinkNonce()
+	if err != nil {
+		adaptor.PostProcess(ctx, c, &req, nil, consts.NewAppErrorWithCode(consts.ErrSystem))
+		return
+	}
+
+	// 调用服务层发送登录链接
+	response, err := authService.SendMagicLink(ctx, &req, nonce)
+	if err == nil {
+		setMagicLinkNonceCookie(c, nonce, consts.MagicLinkExpire)
+	}
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ConsumeMagicLink 使用登录链接登录，必须携带发送链接时设置的nonce cookie
+// 支持直接打开邮件中的链接（GET），也支持前端页面提交token（POST）
+// @router /api/auth/magic/consume [GET]
+// @router /api/auth/magic/consume [POST]
+func ConsumeMagicLink(ctx context.Context, c *app.RequestContext) {
+	// 响应中包含令牌，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	var req Practice.ConsumeMagicLinkReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层使用登录链接
+	nonce := string(c.Cookie(consts.MagicLinkNonceCookie))
+	response, err := authService.ConsumeMagicLink(ctx, &req, nonce, c.ClientIP(), string(c.UserAgent()))
+	if err == nil {
+		// 登录成功后清除nonce，同一浏览器中的其他未使用链接随之失效
+		setMagicLinkNonceCookie(c, "", -1)
+	}
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// StartSocialLogin 开始第三方登录，在当前浏览器设置state cookie后重定向到服务商授权页
+// @router /api/auth/oauth/:provider/start [GET]
+func StartSocialLogin(ctx context.Context, c *app.RequestContext) {
+	var req service.StartSocialLoginReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 服务商名称来自路径参数
+	req.Provider = c.Param("provider")
+
+	// 调用服务层生成授权地址
+	response, err := authService.StartSocialLogin(ctx, &req)
+	if err != nil {
+		adaptor.PostProcess(ctx, c, &req, nil, err)
+		return
+	}
+
+	setSocialStateCookie(c, response.State, consts.SocialStateExpire)
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(hconsts.StatusFound, []byte(response.AuthURL))
+}
+
+// FinishSocialLogin 第三方登录回调，必须携带发起登录时设置的state cookie
+// 支持服务商直接重定向回来（GET），也支持前端页面提交回调参数（POST）
+// @router /api/auth/oauth/:provider/callback [GET]
+// @router /api/auth/oauth/:provider/callback [POST]
+func FinishSocialLogin(ctx context.Context, c *app.RequestContext) {
+	// 响应中包含令牌，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	var req service.FinishSocialLoginReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 服务商名称来自路径参数
+	req.Provider = c.Param("provider")
+
+	// 调用服务层完成第三方登录
+	cookieState := string(c.Cookie(consts.SocialStateCookie))
+	response, err := authService.FinishSocialLogin(ctx, &req, cookieState, c.ClientIP(), string(c.UserAgent()))
+
+	// state只能使用一次，无论成功与否都清除
+	if cookieState != "" {
+		setSocialStateCookie(c, "", -1)
+	}
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// setSocialStateCookie 设置或清除第三方登录state cookie
+// 使用SameSite=Lax，服务商授权后的顶层跳转会携带cookie
+func setSocialStateCookie(c *app.RequestContext, state string, maxAge int) {
+	c.SetCookie(consts.SocialStateCookie, state, maxAge, consts.SocialCookiePath, "",
+		protocol.CookieSameSiteLaxMode, config.GetConfig().SocialLogin.SecureCookie, true)
+}
+
+// setMagicLinkNonceCookie 设置或清除登录链接nonce cookie
+// 使用SameSite=Lax，从邮件客户端点击链接的顶层跳转会携带cookie
+func setMagicLinkNonceCookie(c *app.RequestContext, nonce string, maxAge int) {
+	c.SetCookie(consts.MagicLinkNonceCookie, nonce, maxAge, consts.MagicLinkCookiePath, "",
+		protocol.CookieSameSiteLaxMode, config.GetConfig().MagicLink.SecureCookie, true)
+}
+
+// EnrollTOTP 开始绑定TOTP两步验证
+// @router /api/auth/mfa/totp/enroll [POST]
+func EnrollTOTP(ctx context.Context, c *app.RequestContext) {
+	var req Practice.EnrollTOTPReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.EnrollTOTPResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 响应中包含密钥，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 调用服务层开始绑定
+	response, err := authService.EnrollTOTP(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ConfirmTOTP 确认绑定TOTP两步验证
+// @router /api/auth/mfa/totp/confirm [POST]
+func ConfirmTOTP(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ConfirmTOTPReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ConfirmTOTPResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 响应中包含恢复码，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 调用服务层确认绑定
+	response, err := authService.ConfirmTOTP(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// DisableTOTP 关闭TOTP两步验证
+// @router /api/auth/mfa/totp/disable [POST]
+func DisableTOTP(ctx context.Context, c *app.RequestContext) {
+	var req Practice.DisableTOTPReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.DisableTOTPResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层关闭两步验证
+	response, err := authService.DisableTOTP(ctx, &req, middleware.GetCurrentToken(c), c.ClientIP())
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// LoginWithMFA 使用mfa token和动态验证码完成登录
+// @router /api/auth/login/mfa [POST]
+func LoginWithMFA(ctx context.Context, c *app.RequestContext) {
+	var req Practice.LoginWithMFAReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层完成两步验证登录
+	response, err := authService.LoginWithMFA(ctx, &req, c.ClientIP(), string(c.UserAgent()))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// RegenerateRecoveryCodes 重新生成两步验证恢复码
+// @router /api/auth/mfa/recovery-codes/regenerate [POST]
+func RegenerateRecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	var req Practice.RegenerateRecoveryCodesReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.RegenerateRecoveryCodesResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 响应中包含恢复码，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 调用服务层重新生成恢复码
+	response, err := authService.RegenerateRecoveryCodes(ctx, &req, middleware.GetCurrentToken(c), c.ClientIP())
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// BeginPasskeyRegistration 开始注册通行密钥
+// @router /api/auth/passkeys/register/begin [POST]
+func BeginPasskeyRegistration(ctx context.Context, c *app.RequestContext) {
+	var req Practice.BeginPasskeyRegistrationReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// challenge只能使用一次，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 调用服务层生成注册参数
+	response, err := authService.BeginPasskeyRegistration(ctx, &req, middleware.GetCurrentToken(c), c.ClientIP())
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// FinishPasskeyRegistration 完成注册通行密钥
+// @router /api/auth/passkeys/register/finish [POST]
+func FinishPasskeyRegistration(ctx context.Context, c *app.RequestContext) {
+	var req Practice.FinishPasskeyRegistrationReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层校验并保存通行密钥
+	response, err := authService.FinishPasskeyRegistration(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ListPasskeys 获取当前用户的通行密钥列表
+// @router /api/auth/passkeys [GET]
+func ListPasskeys(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ListPasskeysReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ListPasskeysResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层获取通行密钥列表
+	response, err := authService.ListPasskeys(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// DeletePasskey 删除当前用户的通行密钥
+// @router /api/auth/passkeys/:id [DELETE]
+func DeletePasskey(ctx context.Context, c *app.RequestContext) {
+	var req Practice.DeletePasskeyReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.DeletePasskeyResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 通行密钥ID来自路径参数
+	req.Id = c.Param("id")
+
+	// 调用服务层删除通行密钥
+	response, err := authService.DeletePasskey(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// BeginPasskeyLogin 开始通行密钥登录
+// @router /api/auth/login/passkey/begin [POST]
+func BeginPasskeyLogin(ctx context.Context, c *app.RequestContext) {
+	// challenge只能使用一次，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 调用服务层生成登录参数
+	response, err := authService.BeginPasskeyLogin(ctx)
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, nil, response, err)
+}
+
+// FinishPasskeyLogin 完成通行密钥登录
+// @router /api/auth/login/passkey/finish [POST]
+func FinishPasskeyLogin(ctx context.Context, c *app.RequestContext) {
+	var req Practice.FinishPasskeyLoginReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, adaptor.ResponseData{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 响应中包含令牌，不允许被缓存
+	c.Header("Cache-Control", "no-store")
+
+	// 调用服务层校验通行密钥并签发令牌
+	response, err := authService.FinishPasskeyLogin(ctx, &req, c.ClientIP(), string(c.UserAgent()))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// ListIdentities 获取当前用户绑定的邮箱和手机号
+// @router /api/auth/identities [GET]
+func ListIdentities(ctx context.Context, c *app.RequestContext) {
+	var req Practice.ListIdentitiesReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.ListIdentitiesResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层获取登录标识列表
+	response, err := authService.ListIdentities(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// SendIdentityCode 向要绑定的邮箱或手机号发送验证码
+// @router /api/auth/identities/send-code [POST]
+func SendIdentityCode(ctx context.Context, c *app.RequestContext) {
+	var req Practice.SendIdentityCodeReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.SendIdentityCodeResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 调用服务层发送验证码
+	response, err := authService.SendIdentityCode(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// AttachIdentity 为当前用户绑定邮箱或手机号
+// @router /api/auth/identities [POST]
+func AttachIdentity(ctx context.Context, c *app.RequestContext) {
+	var req Practice.AttachIdentityReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.AttachIdentityResp{
+			Code: 1001, // 参数错误
+			Msg:  "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 调用服务层绑定登录标识
+	response, err := authService.AttachIdentity(ctx, &req, middleware.GetCurrentToken(c), c.ClientIP())
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
+
+// DetachIdentity 解绑当前用户的邮箱或手机号
+// @router /api/auth/identities/:id [DELETE]
+func DetachIdentity(ctx context.Context, c *app.RequestContext) {
+	var req Practice.DetachIdentityReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &Practice.DetachIdentityResp{
+			Code:    1001, // 参数错误
+			Msg:     "参数错误: " + err.Error(),
+			Message: "参数错误",
+		})
+		return
+	}
+
+	// 登录标识ID来自路径参数
+	req.Id = c.Param("id")
+
+	// 调用服务层解绑登录标识
+	response, err := authService.DetachIdentity(ctx, &req, middleware.GetCurrentToken(c))
+
+	// 返回响应
+	adaptor.PostProcess(ctx, c, &req, response, err)
+}
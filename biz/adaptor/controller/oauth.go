@@ -0,0 +1,271 @@
+package controller
+
+import (
+	"auth/biz/adaptor"
+	"auth/biz/adaptor/middleware"
+	"auth/biz/application/service"
+	"auth/biz/infrastructure/consts"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"html/template"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	hconsts "github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// 创建服务实例
+var authService = service.NewAuthService()
+
+// authorizePage OAuth授权页，登录即视为同意授权
+var authorizePage = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>授权登录</title>
+<style>
+body { font-family: sans-serif; background: #f5f5f5; }
+main { max-width: 360px; margin: 64px auto; padding: 24px; background: #fff; border-radius: 8px; }
+label { display: block; margin: 12px 0; }
+input[type=text], input[type=password] { width: 100%; box-sizing: border-box; padding: 8px; }
+.error { color: #c00; }
+</style>
+</head>
+<body>
+<main>
+<h2>{{.ClientName}} 请求访问您的账号</h2>
+{{if .Scopes}}<p>申请的权限：</p>
+<ul>{{range .Scopes}}<li>{{.}}</li>{{end}}</ul>{{end}}
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+<form method="post" action="/oauth/authorize">
+<input type="hidden" name="response_type" value="{{.Request.ResponseType}}">
+<input type="hidden" name="client_id" value="{{.Request.ClientID}}">
+<input type="hidden" name="redirect_uri" value="{{.Request.RedirectURI}}">
+<input type="hidden" name="scope" value="{{.Request.Scope}}">
+<input type="hidden" name="state" value="{{.Request.State}}">
+<input type="hidden" name="code_challenge" value="{{.Request.CodeChallenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Request.CodeChallengeMethod}}">
+<input type="hidden" name="nonce" value="{{.Request.Nonce}}">
+<label>邮箱或手机号<input type="text" name="username" value="{{.Request.Username}}" autocomplete="username" required></label>
+<label>密码<input type="password" name="password" autocomplete="current-password" required></label>
+<label>动态验证码或恢复码（已开启两步验证时填写）<input type="text" name="totp_code" autocomplete="one-time-code" maxlength="64"></label>
+<button type="submit" name="action" value="approve">登录并授权</button>
+<button type="submit" name="action" value="deny" formnovalidate>拒绝</button>
+</form>
+</main>
+</body>
+</html>
+`))
+
+// authorizeErrorPage 无法重定向回客户端时展示的错误页
+var authorizeErrorPage = template.Must(template.New("authorize_error").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head><meta charset="utf-8"><title>授权请求无效</title></head>
+<body>
+<h2>授权请求无效</h2>
+<p>{{.}}</p>
+</body>
+</html>
+`))
+
+// authorizeRetryErrors 可在授权页修改后重新提交的错误
+var authorizeRetryErrors = []int{
+	consts.ErrParams,
+	consts.ErrPhoneInvalid,
+	consts.ErrInvalidCredentials,
+	consts.ErrLoginLocked,
+	consts.ErrMFACodeInvalid,
+	consts.ErrRecoveryCodeInvalid,
+}
+
+// OAuthAuthorize OAuth授权端点，校验授权请求并展示登录授权页
+// @router /oauth/authorize [GET]
+func OAuthAuthorize(ctx context.Context, c *app.RequestContext) {
+	var req service.AuthorizeReq
+	if err := c.BindAndValidate(&req); err != nil {
+		renderAuthorizeError(c, hconsts.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+
+	prompt, err := authService.PrepareAuthorize(ctx, &req)
+	if err != nil {
+		handleAuthorizeError(c, err)
+		return
+	}
+
+	renderAuthorizePage(c, hconsts.StatusOK, prompt)
+}
+
+// OAuthAuthorizeSubmit 处理授权页提交，成功后携带授权码重定向回客户端
+// @router /oauth/authorize [POST]
+func OAuthAuthorizeSubmit(ctx context.Context, c *app.RequestContext) {
+	var req service.AuthorizeReq
+	if err := c.BindAndValidate(&req); err != nil {
+		renderAuthorizeError(c, hconsts.StatusBadRequest, "参数错误: "+err.Error())
+		return
+	}
+
+	location, err := authService.Authorize(ctx, &req, c.ClientIP())
+	if err == nil {
+		c.Redirect(hconsts.StatusFound, []byte(location))
+		return
+	}
+
+	// 账号填写有误、账号密码错误、动态验证码或恢复码错误、登录被锁定时重新展示授权页
+	var appErr *consts.AppError
+	if errors.As(err, &appErr) && slices.Contains(authorizeRetryErrors, appErr.Code) {
+		prompt, promptErr := authService.PrepareAuthorize(ctx, &req)
+		if promptErr != nil {
+			handleAuthorizeError(c, promptErr)
+			return
+		}
+		prompt.Error = appErr.Msg
+		renderAuthorizePage(c, hconsts.StatusOK, prompt)
+		return
+	}
+
+	handleAuthorizeError(c, err)
+}
+
+// OAuthToken OAuth令牌端点，支持authorization_code、refresh_token和client_credentials授权类型
+// 客户端可通过HTTP Basic认证或表单中的client_id/client_secret认证
+// @router /oauth/token [POST]
+func OAuthToken(ctx context.Context, c *app.RequestContext) {
+	// 令牌响应不允许被缓存（RFC 6749 5.1）
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	var req service.TokenReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(hconsts.StatusBadRequest, &service.OAuthError{
+			Code:        service.OAuthErrInvalidRequest,
+			Description: "参数错误: " + err.Error(),
+		})
+		return
+	}
+
+	// 解析HTTP Basic认证，客户端只能使用一种认证方式
+	if clientID, clientSecret, ok := parseBasicAuth(c.Request.Header.Get(consts.TokenHeader)); ok {
+		if req.ClientSecret != "" || (req.ClientID != "" && req.ClientID != clientID) {
+			c.JSON(hconsts.StatusBadRequest, &service.OAuthError{
+				Code:        service.OAuthErrInvalidRequest,
+				Description: "只能使用一种客户端认证方式",
+			})
+			return
+		}
+		req.ClientID = clientID
+		req.ClientSecret = clientSecret
+	}
+
+	response, err := authService.Token(ctx, &req, c.ClientIP(), string(c.UserAgent()))
+	if err != nil {
+		var oauthErr *service.OAuthError
+		if errors.As(err, &oauthErr) {
+			if oauthErr.Status == hconsts.StatusUnauthorized {
+				c.Header("WWW-Authenticate", `Basic realm="oauth"`)
+			}
+			c.JSON(oauthErr.Status, oauthErr)
+			return
+		}
+
+		c.JSON(hconsts.StatusInternalServerError, &service.OAuthError{
+			Code:        service.OAuthErrServerError,
+			Description: consts.ErrMsg[consts.ErrSystem],
+		})
+		return
+	}
+
+	c.JSON(hconsts.StatusOK, response)
+}
+
+// UserInfo OIDC用户信息端点，需携带包含openid授权范围的access token
+// @router /userinfo [GET]
+func UserInfo(ctx context.Context, c *app.RequestContext) {
+	response, err := authService.UserInfo(ctx, middleware.GetCurrentToken(c))
+	if err != nil {
+		var oauthErr *service.OAuthError
+		if errors.As(err, &oauthErr) {
+			c.Header("WWW-Authenticate", `Bearer error="`+oauthErr.Code+`"`)
+			c.JSON(oauthErr.Status, oauthErr)
+			return
+		}
+		adaptor.PostProcess(ctx, c, nil, nil, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(hconsts.StatusOK, response)
+}
+
+// handleAuthorizeError 授权端点的错误处理：能确定回调地址时重定向回客户端，否则展示错误页
+func handleAuthorizeError(c *app.RequestContext, err error) {
+	var oauthErr *service.OAuthError
+	if !errors.As(err, &oauthErr) {
+		renderAuthorizeError(c, hconsts.StatusInternalServerError, consts.ErrMsg[consts.ErrSystem])
+		return
+	}
+
+	if oauthErr.RedirectURI != "" {
+		c.Redirect(hconsts.StatusFound, []byte(oauthErr.RedirectURL()))
+		return
+	}
+	renderAuthorizeError(c, oauthErr.Status, oauthErr.Description)
+}
+
+// renderAuthorizePage 渲染授权页，禁止被嵌入iframe以防点击劫持
+func renderAuthorizePage(c *app.RequestContext, status int, prompt *service.AuthorizePrompt) {
+	var buf bytes.Buffer
+	if err := authorizePage.Execute(&buf, prompt); err != nil {
+		renderAuthorizeError(c, hconsts.StatusInternalServerError, consts.ErrMsg[consts.ErrSystem])
+		return
+	}
+	writeHTML(c, status, buf.Bytes())
+}
+
+// renderAuthorizeError 渲染授权错误页
+func renderAuthorizeError(c *app.RequestContext, status int, message string) {
+	var buf bytes.Buffer
+	_ = authorizeErrorPage.Execute(&buf, message)
+	writeHTML(c, status, buf.Bytes())
+}
+
+// writeHTML 输出HTML页面
+func writeHTML(c *app.RequestContext, status int, body []byte) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Frame-Options", "DENY")
+	c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors 'none'")
+	c.Data(status, "text/html; charset=utf-8", body)
+}
+
+// parseBasicAuth 解析HTTP Basic认证头，client_id和client_secret按RFC 6749 2.3.1做过URL编码
+func parseBasicAuth(header string) (string, string, bool) {
+	const prefix = "Basic "
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(header[len(prefix):])
+	if err != nil {
+		return "", "", false
+	}
+
+	clientID, clientSecret, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", false
+	}
+
+	clientID, err = url.QueryUnescape(clientID)
+	if err != nil {
+		return "", "", false
+	}
+	clientSecret, err = url.QueryUnescape(clientSecret)
+	if err != nil {
+		return "", "", false
+	}
+	return clientID, clientSecret, true
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"auth/biz/adaptor"
+	"auth/biz/infrastructure/jwt"
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// JWKS 公开签名公钥（JSON Web Key Set），供其他服务离线验证token
+// @router /.well-known/jwks.json [GET]
+func JWKS(ctx context.Context, c *app.RequestContext) {
+	ring, err := jwt.GetKeyring()
+	if err != nil {
+		adaptor.PostProcess(ctx, c, nil, nil, err)
+		return
+	}
+
+	// 允许验证方短暂缓存，密钥轮换时新旧密钥会同时出现在列表中
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(consts.StatusOK, ring.JWKS())
+}
+
+// OpenIDConfiguration OIDC发现文档
+// @router /.well-known/openid-configuration [GET]
+func OpenIDConfiguration(ctx context.Context, c *app.RequestContext) {
+	document, err := authService.GetOpenIDConfiguration(ctx)
+	if err != nil {
+		adaptor.PostProcess(ctx, c, nil, nil, err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(consts.StatusOK, document)
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"auth/biz/application/service"
 	"auth/biz/infrastructure/consts"
 	"auth/biz/infrastructure/jwt"
 	"context"
@@ -49,8 +50,40 @@ func JWTAuth() app.HandlerFunc {
 		// 将用户信息存储在上下文中，便于后续操作
 		c.Set("userId", claims.UserId)
 		c.Set("userEmail", claims.Email)
+		c.Set("tokenId", claims.Id)
+		c.Set("sessionId", claims.SessionId)
+		c.Set("subjectType", claims.SubjectType())
+		c.Set("tokenScope", claims.Scope)
+		c.Set("tokenExpire", claims.ExpiresAt)
 
 		// 继续处理请求
 		c.Next(ctx)
 	}
 }
+
+// GetCurrentToken 从上下文中获取JWTAuth中间件写入的当前token信息
+func GetCurrentToken(c *app.RequestContext) *service.CurrentToken {
+	current := &service.CurrentToken{}
+	if userID, ok := c.Get("userId"); ok && userID != nil {
+		current.UserID = userID.(string)
+	}
+	if userEmail, ok := c.Get("userEmail"); ok && userEmail != nil {
+		current.Email = userEmail.(string)
+	}
+	if tokenID, ok := c.Get("tokenId"); ok && tokenID != nil {
+		current.TokenID = tokenID.(string)
+	}
+	if sessionID, ok := c.Get("sessionId"); ok && sessionID != nil {
+		current.SessionID = sessionID.(string)
+	}
+	if subType, ok := c.Get("subjectType"); ok && subType != nil {
+		current.SubjectType = subType.(string)
+	}
+	if scope, ok := c.Get("tokenScope"); ok && scope != nil {
+		current.Scope = scope.(string)
+	}
+	if expire, ok := c.Get("tokenExpire"); ok && expire != nil {
+		current.ExpireAt = expire.(int64)
+	}
+	return current
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"auth/biz/infrastructure/consts"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	hconsts "github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// RequireScopes 中间件要求当前token包含全部指定的授权范围，需放在JWTAuth之后
+// 缺少任一授权范围时返回403
+func RequireScopes(scopes ...string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		granted := strings.Fields(GetCurrentToken(c).Scope)
+		for _, scope := range scopes {
+			if !slices.Contains(granted, scope) {
+				c.JSON(hconsts.StatusForbidden, map[string]interface{}{
+					"code": consts.ErrForbidden,
+					"msg":  consts.ErrMsg[consts.ErrForbidden] + "，缺少授权范围: " + scope,
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		// 继续处理请求
+		c.Next(ctx)
+	}
+}
@@ -5,6 +5,7 @@ package Practice
 import (
 	Practice "auth/biz/adaptor/controller/Practice"
 	"auth/biz/adaptor/middleware"
+	"auth/biz/infrastructure/consts"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
@@ -24,12 +25,63 @@ func Register(r *server.Hertz) {
 		auth.POST("/verify-code", Practice.VerifyCode)              // 验证验证码
 		auth.POST("/register", Practice.Register)                   // 用户注册
 		auth.POST("/login", Practice.Login)                         // 用户登录
+		auth.POST("/login/code/send", Practice.SendLoginCode)       // 发送登录验证码
+		auth.POST("/login/code", Practice.LoginWithCode)            // 验证码登录
+		auth.POST("/login/mfa", Practice.LoginWithMFA)              // 两步验证登录
+		auth.POST("/login/passkey/begin", Practice.BeginPasskeyLogin)   // 开始通行密钥登录
+		auth.POST("/login/passkey/finish", Practice.FinishPasskeyLogin) // 完成通行密钥登录
+		auth.POST("/magic/send", Practice.SendMagicLink)            // 发送登录链接
+		auth.GET("/magic/consume", Practice.ConsumeMagicLink)       // 打开登录链接登录
+		auth.POST("/magic/consume", Practice.ConsumeMagicLink)      // 前端提交登录链接token登录
+		auth.GET("/oauth/:provider/start", Practice.StartSocialLogin)       // 开始第三方登录，重定向到服务商授权页
+		auth.GET("/oauth/:provider/callback", Practice.FinishSocialLogin)   // 服务商授权后回调登录
+		auth.POST("/oauth/:provider/callback", Practice.FinishSocialLogin)  // 前端提交回调参数登录
+		auth.POST("/refresh", Practice.RefreshToken)                // 刷新令牌
+		auth.POST("/password/forgot", Practice.ForgotPassword)      // 忘记密码，发送重置密码验证码
+		auth.POST("/password/reset", Practice.ResetPassword)        // 使用验证码重置密码
 
 		// 需要身份验证的路由
 		authRequired := auth.Group("", middleware.JWTAuth())
 		{
-			authRequired.GET("/user-info", Practice.GetUserInfo)     // 获取用户信息
-			authRequired.POST("/kick", Practice.KickUser)            // 踢出用户（管理员功能）
+			authRequired.POST("/logout", Practice.Logout)            // 退出登录
+
+			// 令牌自省 - 需要auth:introspect授权范围，普通登录令牌不能调用
+			authRequired.POST("/introspect", middleware.RequireScopes(consts.ScopeIntrospect), Practice.Introspect)
+
+			// 只读接口 - 需要auth:read授权范围
+			readScope := authRequired.Group("", middleware.RequireScopes(consts.ScopeAuthRead))
+			{
+				readScope.GET("/user-info", Practice.GetUserInfo)                   // 获取用户信息
+				readScope.GET("/sessions", Practice.ListSessions)                   // 获取登录会话列表
+				readScope.GET("/passkeys", Practice.ListPasskeys)                   // 获取通行密钥列表
+				readScope.GET("/identities", Practice.ListIdentities)               // 获取绑定的邮箱和手机号列表
+				readScope.GET("/clients", Practice.ListClients)                     // 获取OAuth客户端列表（管理员功能）
+				readScope.GET("/service-accounts", Practice.ListServiceAccounts)    // 获取服务账号列表（管理员功能）
+			}
+
+			// 修改接口 - 需要auth:write授权范围
+			writeScope := authRequired.Group("", middleware.RequireScopes(consts.ScopeAuthWrite))
+			{
+				writeScope.POST("/logout-all", Practice.LogoutAll)                   // 退出所有会话
+				writeScope.POST("/kick", Practice.KickUser)                          // 踢出用户（管理员功能）
+				writeScope.DELETE("/sessions/:id", Practice.RevokeSession)           // 吊销登录会话
+				writeScope.POST("/password/change", Practice.ChangePassword)         // 修改密码
+				writeScope.POST("/mfa/totp/enroll", Practice.EnrollTOTP)             // 开始绑定TOTP两步验证
+				writeScope.POST("/mfa/totp/confirm", Practice.ConfirmTOTP)           // 确认绑定TOTP两步验证
+				writeScope.POST("/mfa/totp/disable", Practice.DisableTOTP)           // 关闭TOTP两步验证
+				writeScope.POST("/mfa/recovery-codes/regenerate", Practice.RegenerateRecoveryCodes) // 重新生成两步验证恢复码
+				writeScope.POST("/passkeys/register/begin", Practice.BeginPasskeyRegistration)   // 开始注册通行密钥
+				writeScope.POST("/passkeys/register/finish", Practice.FinishPasskeyRegistration) // 完成注册通行密钥
+				writeScope.DELETE("/passkeys/:id", Practice.DeletePasskey)           // 删除通行密钥
+				writeScope.POST("/identities/send-code", Practice.SendIdentityCode)  // 发送绑定邮箱或手机号的验证码
+				writeScope.POST("/identities", Practice.AttachIdentity)              // 绑定邮箱或手机号
+				writeScope.DELETE("/identities/:id", Practice.DetachIdentity)        // 解绑邮箱或手机号
+				writeScope.POST("/clients", Practice.CreateClient)                   // 注册OAuth客户端（管理员功能）
+				writeScope.DELETE("/clients/:clientId", Practice.DeleteClient)       // 删除OAuth客户端（管理员功能）
+				writeScope.POST("/service-accounts", Practice.CreateServiceAccount)  // 创建服务账号（管理员功能）
+				writeScope.POST("/service-accounts/:clientId/rotate", Practice.RotateServiceAccountSecret) // 轮换服务账号密钥（管理员功能）
+				writeScope.PUT("/service-accounts/:clientId/status", Practice.SetServiceAccountStatus)     // 启用或停用服务账号（管理员功能）
+			}
 		}
 	}
 }
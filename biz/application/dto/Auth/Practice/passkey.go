@@ -0,0 +1,55 @@
+package Practice
+
+import (
+	"encoding/json"
+
+	"github.com/go-webauthn/webauthn/protocol"
+)
+
+// 通行密钥注册和登录的请求与响应
+// WebAuthn的options和credential是浏览器API直接使用的JSON对象，无法用IDL描述，因此手写在此处，hz update不会覆盖
+
+// BeginPasskeyRegistrationReq 开始注册通行密钥请求
+type BeginPasskeyRegistrationReq struct {
+	Password string `json:"password"` // 当前密码，重新验证身份，与totpCode二选一
+	TotpCode string `json:"totpCode"` // 已开启两步验证时可填写动态验证码代替当前密码
+}
+
+// BeginPasskeyRegistrationResp 开始注册通行密钥响应
+// options.publicKey解码base64url字段后传给navigator.credentials.create()
+type BeginPasskeyRegistrationResp struct {
+	Code       int64                        `json:"code"`
+	Msg        string                       `json:"msg"`
+	CeremonyID string                       `json:"ceremonyId"` // 完成注册时原样提交
+	Options    *protocol.CredentialCreation `json:"options"`
+}
+
+// FinishPasskeyRegistrationReq 完成注册通行密钥请求
+type FinishPasskeyRegistrationReq struct {
+	CeremonyID string          `json:"ceremonyId"`
+	Name       string          `json:"name"`       // 通行密钥名称，便于在列表中区分，可选
+	Credential json.RawMessage `json:"credential"` // navigator.credentials.create()返回的PublicKeyCredential，二进制字段使用base64url编码
+}
+
+// FinishPasskeyRegistrationResp 完成注册通行密钥响应
+type FinishPasskeyRegistrationResp struct {
+	Code    int64        `json:"code"`
+	Msg     string       `json:"msg"`
+	Passkey *PasskeyInfo `json:"passkey"`
+}
+
+// BeginPasskeyLoginResp 开始通行密钥登录响应
+// options.publicKey解码base64url字段后传给navigator.credentials.get()
+type BeginPasskeyLoginResp struct {
+	Code       int64                         `json:"code"`
+	Msg        string                        `json:"msg"`
+	CeremonyID string                        `json:"ceremonyId"` // 完成登录时原样提交
+	Options    *protocol.CredentialAssertion `json:"options"`
+}
+
+// FinishPasskeyLoginReq 完成通行密钥登录请求
+type FinishPasskeyLoginReq struct {
+	CeremonyID string          `json:"ceremonyId"`
+	Credential json.RawMessage `json:"credential"` // navigator.credentials.get()返回的PublicKeyCredential，二进制字段使用base64url编码
+	Scope      string          `json:"scope"`      // 申请的授权范围，为空时授予全部第一方授权范围
+}
@@ -25,7 +25,7 @@ var file_practice_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x1a,
 	0x1a, 0x41, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe1, 0x03, 0x0a, 0x0b,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd2, 0x18, 0x0a, 0x0b,
 	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x53,
 	0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
 	0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74,
@@ -55,26 +55,253 @@ var file_practice_proto_rawDesc = []byte{
 	0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63,
 	0x74, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
 	0x1a, 0x1b, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
-	0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42,
-	0x28, 0x5a, 0x26, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x41, 0x75, 0x74, 0x68,
-	0x2f, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c,
+	0x12, 0x1b, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e,
+	0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x7b, 0x0a, 0x1a, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2c, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x17,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x67, 0x69,
+	0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x67, 0x69, 0x63,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x22,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1c, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1d, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1d, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x46, 0x41, 0x12, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x4d, 0x46, 0x41, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x4d, 0x46, 0x41, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x20,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x72, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e,
+	0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e,
+	0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x5d, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x57,
+	0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x2e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x42, 0x28, 0x5a, 0x26, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x41, 0x75, 0x74,
+	0x68, 0x2f, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var file_practice_proto_goTypes = []interface{}{
-	(*SendVerificationCodeReq)(nil),  // 0: Auth.practice.SendVerificationCodeReq
-	(*VerifyCodeReq)(nil),            // 1: Auth.practice.VerifyCodeReq
-	(*RegisterReq)(nil),              // 2: Auth.practice.RegisterReq
-	(*LoginReq)(nil),                 // 3: Auth.practice.LoginReq
-	(*GetUserInfoReq)(nil),           // 4: Auth.practice.GetUserInfoReq
-	(*KickUserReq)(nil),              // 5: Auth.practice.KickUserReq
-	(*SendVerificationCodeResp)(nil), // 6: Auth.practice.SendVerificationCodeResp
-	(*VerifyCodeResp)(nil),           // 7: Auth.practice.VerifyCodeResp
-	(*RegisterResp)(nil),             // 8: Auth.practice.RegisterResp
-	(*LoginResp)(nil),                // 9: Auth.practice.LoginResp
-	(*GetUserInfoResp)(nil),          // 10: Auth.practice.GetUserInfoResp
-	(*KickUserResp)(nil),             // 11: Auth.practice.KickUserResp
+	(*SendVerificationCodeReq)(nil),        // 0: Auth.practice.SendVerificationCodeReq
+	(*VerifyCodeReq)(nil),                  // 1: Auth.practice.VerifyCodeReq
+	(*RegisterReq)(nil),                    // 2: Auth.practice.RegisterReq
+	(*LoginReq)(nil),                       // 3: Auth.practice.LoginReq
+	(*GetUserInfoReq)(nil),                 // 4: Auth.practice.GetUserInfoReq
+	(*KickUserReq)(nil),                    // 5: Auth.practice.KickUserReq
+	(*RefreshTokenReq)(nil),                // 6: Auth.practice.RefreshTokenReq
+	(*LogoutReq)(nil),                      // 7: Auth.practice.LogoutReq
+	(*LogoutAllReq)(nil),                   // 8: Auth.practice.LogoutAllReq
+	(*ListSessionsReq)(nil),                // 9: Auth.practice.ListSessionsReq
+	(*RevokeSessionReq)(nil),               // 10: Auth.practice.RevokeSessionReq
+	(*CreateClientReq)(nil),                // 11: Auth.practice.CreateClientReq
+	(*ListClientsReq)(nil),                 // 12: Auth.practice.ListClientsReq
+	(*DeleteClientReq)(nil),                // 13: Auth.practice.DeleteClientReq
+	(*CreateServiceAccountReq)(nil),        // 14: Auth.practice.CreateServiceAccountReq
+	(*ListServiceAccountsReq)(nil),         // 15: Auth.practice.ListServiceAccountsReq
+	(*RotateServiceAccountSecretReq)(nil),  // 16: Auth.practice.RotateServiceAccountSecretReq
+	(*SetServiceAccountStatusReq)(nil),     // 17: Auth.practice.SetServiceAccountStatusReq
+	(*ForgotPasswordReq)(nil),              // 18: Auth.practice.ForgotPasswordReq
+	(*ResetPasswordReq)(nil),               // 19: Auth.practice.ResetPasswordReq
+	(*ChangePasswordReq)(nil),              // 20: Auth.practice.ChangePasswordReq
+	(*SendLoginCodeReq)(nil),               // 21: Auth.practice.SendLoginCodeReq
+	(*LoginWithCodeReq)(nil),               // 22: Auth.practice.LoginWithCodeReq
+	(*SendMagicLinkReq)(nil),               // 23: Auth.practice.SendMagicLinkReq
+	(*ConsumeMagicLinkReq)(nil),            // 24: Auth.practice.ConsumeMagicLinkReq
+	(*EnrollTOTPReq)(nil),                  // 25: Auth.practice.EnrollTOTPReq
+	(*ConfirmTOTPReq)(nil),                 // 26: Auth.practice.ConfirmTOTPReq
+	(*DisableTOTPReq)(nil),                 // 27: Auth.practice.DisableTOTPReq
+	(*LoginWithMFAReq)(nil),                // 28: Auth.practice.LoginWithMFAReq
+	(*ListPasskeysReq)(nil),                // 29: Auth.practice.ListPasskeysReq
+	(*DeletePasskeyReq)(nil),               // 30: Auth.practice.DeletePasskeyReq
+	(*RegenerateRecoveryCodesReq)(nil),     // 31: Auth.practice.RegenerateRecoveryCodesReq
+	(*ListIdentitiesReq)(nil),              // 32: Auth.practice.ListIdentitiesReq
+	(*SendIdentityCodeReq)(nil),            // 33: Auth.practice.SendIdentityCodeReq
+	(*AttachIdentityReq)(nil),              // 34: Auth.practice.AttachIdentityReq
+	(*DetachIdentityReq)(nil),              // 35: Auth.practice.DetachIdentityReq
+	(*SendVerificationCodeResp)(nil),       // 36: Auth.practice.SendVerificationCodeResp
+	(*VerifyCodeResp)(nil),                 // 37: Auth.practice.VerifyCodeResp
+	(*RegisterResp)(nil),                   // 38: Auth.practice.RegisterResp
+	(*LoginResp)(nil),                      // 39: Auth.practice.LoginResp
+	(*GetUserInfoResp)(nil),                // 40: Auth.practice.GetUserInfoResp
+	(*KickUserResp)(nil),                   // 41: Auth.practice.KickUserResp
+	(*RefreshTokenResp)(nil),               // 42: Auth.practice.RefreshTokenResp
+	(*LogoutResp)(nil),                     // 43: Auth.practice.LogoutResp
+	(*LogoutAllResp)(nil),                  // 44: Auth.practice.LogoutAllResp
+	(*ListSessionsResp)(nil),               // 45: Auth.practice.ListSessionsResp
+	(*RevokeSessionResp)(nil),              // 46: Auth.practice.RevokeSessionResp
+	(*CreateClientResp)(nil),               // 47: Auth.practice.CreateClientResp
+	(*ListClientsResp)(nil),                // 48: Auth.practice.ListClientsResp
+	(*DeleteClientResp)(nil),               // 49: Auth.practice.DeleteClientResp
+	(*CreateServiceAccountResp)(nil),       // 50: Auth.practice.CreateServiceAccountResp
+	(*ListServiceAccountsResp)(nil),        // 51: Auth.practice.ListServiceAccountsResp
+	(*RotateServiceAccountSecretResp)(nil), // 52: Auth.practice.RotateServiceAccountSecretResp
+	(*SetServiceAccountStatusResp)(nil),    // 53: Auth.practice.SetServiceAccountStatusResp
+	(*ForgotPasswordResp)(nil),             // 54: Auth.practice.ForgotPasswordResp
+	(*ResetPasswordResp)(nil),              // 55: Auth.practice.ResetPasswordResp
+	(*ChangePasswordResp)(nil),             // 56: Auth.practice.ChangePasswordResp
+	(*SendLoginCodeResp)(nil),              // 57: Auth.practice.SendLoginCodeResp
+	(*LoginWithCodeResp)(nil),              // 58: Auth.practice.LoginWithCodeResp
+	(*SendMagicLinkResp)(nil),              // 59: Auth.practice.SendMagicLinkResp
+	(*ConsumeMagicLinkResp)(nil),           // 60: Auth.practice.ConsumeMagicLinkResp
+	(*EnrollTOTPResp)(nil),                 // 61: Auth.practice.EnrollTOTPResp
+	(*ConfirmTOTPResp)(nil),                // 62: Auth.practice.ConfirmTOTPResp
+	(*DisableTOTPResp)(nil),                // 63: Auth.practice.DisableTOTPResp
+	(*LoginWithMFAResp)(nil),               // 64: Auth.practice.LoginWithMFAResp
+	(*ListPasskeysResp)(nil),               // 65: Auth.practice.ListPasskeysResp
+	(*DeletePasskeyResp)(nil),              // 66: Auth.practice.DeletePasskeyResp
+	(*RegenerateRecoveryCodesResp)(nil),    // 67: Auth.practice.RegenerateRecoveryCodesResp
+	(*ListIdentitiesResp)(nil),             // 68: Auth.practice.ListIdentitiesResp
+	(*SendIdentityCodeResp)(nil),           // 69: Auth.practice.SendIdentityCodeResp
+	(*AttachIdentityResp)(nil),             // 70: Auth.practice.AttachIdentityResp
+	(*DetachIdentityResp)(nil),             // 71: Auth.practice.DetachIdentityResp
 }
 var file_practice_proto_depIdxs = []int32{
 	0,  // 0: Auth.practice.AuthService.SendVerificationCode:input_type -> Auth.practice.SendVerificationCodeReq
@@ -83,14 +310,74 @@ var file_practice_proto_depIdxs = []int32{
 	3,  // 3: Auth.practice.AuthService.Login:input_type -> Auth.practice.LoginReq
 	4,  // 4: Auth.practice.AuthService.GetUserInfo:input_type -> Auth.practice.GetUserInfoReq
 	5,  // 5: Auth.practice.AuthService.KickUser:input_type -> Auth.practice.KickUserReq
-	6,  // 6: Auth.practice.AuthService.SendVerificationCode:output_type -> Auth.practice.SendVerificationCodeResp
-	7,  // 7: Auth.practice.AuthService.VerifyCode:output_type -> Auth.practice.VerifyCodeResp
-	8,  // 8: Auth.practice.AuthService.Register:output_type -> Auth.practice.RegisterResp
-	9,  // 9: Auth.practice.AuthService.Login:output_type -> Auth.practice.LoginResp
-	10, // 10: Auth.practice.AuthService.GetUserInfo:output_type -> Auth.practice.GetUserInfoResp
-	11, // 11: Auth.practice.AuthService.KickUser:output_type -> Auth.practice.KickUserResp
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	6,  // 6: Auth.practice.AuthService.RefreshToken:input_type -> Auth.practice.RefreshTokenReq
+	7,  // 7: Auth.practice.AuthService.Logout:input_type -> Auth.practice.LogoutReq
+	8,  // 8: Auth.practice.AuthService.LogoutAll:input_type -> Auth.practice.LogoutAllReq
+	9,  // 9: Auth.practice.AuthService.ListSessions:input_type -> Auth.practice.ListSessionsReq
+	10, // 10: Auth.practice.AuthService.RevokeSession:input_type -> Auth.practice.RevokeSessionReq
+	11, // 11: Auth.practice.AuthService.CreateClient:input_type -> Auth.practice.CreateClientReq
+	12, // 12: Auth.practice.AuthService.ListClients:input_type -> Auth.practice.ListClientsReq
+	13, // 13: Auth.practice.AuthService.DeleteClient:input_type -> Auth.practice.DeleteClientReq
+	14, // 14: Auth.practice.AuthService.CreateServiceAccount:input_type -> Auth.practice.CreateServiceAccountReq
+	15, // 15: Auth.practice.AuthService.ListServiceAccounts:input_type -> Auth.practice.ListServiceAccountsReq
+	16, // 16: Auth.practice.AuthService.RotateServiceAccountSecret:input_type -> Auth.practice.RotateServiceAccountSecretReq
+	17, // 17: Auth.practice.AuthService.SetServiceAccountStatus:input_type -> Auth.practice.SetServiceAccountStatusReq
+	18, // 18: Auth.practice.AuthService.ForgotPassword:input_type -> Auth.practice.ForgotPasswordReq
+	19, // 19: Auth.practice.AuthService.ResetPassword:input_type -> Auth.practice.ResetPasswordReq
+	20, // 20: Auth.practice.AuthService.ChangePassword:input_type -> Auth.practice.ChangePasswordReq
+	21, // 21: Auth.practice.AuthService.SendLoginCode:input_type -> Auth.practice.SendLoginCodeReq
+	22, // 22: Auth.practice.AuthService.LoginWithCode:input_type -> Auth.practice.LoginWithCodeReq
+	23, // 23: Auth.practice.AuthService.SendMagicLink:input_type -> Auth.practice.SendMagicLinkReq
+	24, // 24: Auth.practice.AuthService.ConsumeMagicLink:input_type -> Auth.practice.ConsumeMagicLinkReq
+	25, // 25: Auth.practice.AuthService.EnrollTOTP:input_type -> Auth.practice.EnrollTOTPReq
+	26, // 26: Auth.practice.AuthService.ConfirmTOTP:input_type -> Auth.practice.ConfirmTOTPReq
+	27, // 27: Auth.practice.AuthService.DisableTOTP:input_type -> Auth.practice.DisableTOTPReq
+	28, // 28: Auth.practice.AuthService.LoginWithMFA:input_type -> Auth.practice.LoginWithMFAReq
+	29, // 29: Auth.practice.AuthService.ListPasskeys:input_type -> Auth.practice.ListPasskeysReq
+	30, // 30: Auth.practice.AuthService.DeletePasskey:input_type -> Auth.practice.DeletePasskeyReq
+	31, // 31: Auth.practice.AuthService.RegenerateRecoveryCodes:input_type -> Auth.practice.RegenerateRecoveryCodesReq
+	32, // 32: Auth.practice.AuthService.ListIdentities:input_type -> Auth.practice.ListIdentitiesReq
+	33, // 33: Auth.practice.AuthService.SendIdentityCode:input_type -> Auth.practice.SendIdentityCodeReq
+	34, // 34: Auth.practice.AuthService.AttachIdentity:input_type -> Auth.practice.AttachIdentityReq
+	35, // 35: Auth.practice.AuthService.DetachIdentity:input_type -> Auth.practice.DetachIdentityReq
+	36, // 36: Auth.practice.AuthService.SendVerificationCode:output_type -> Auth.practice.SendVerificationCodeResp
+	37, // 37: Auth.practice.AuthService.VerifyCode:output_type -> Auth.practice.VerifyCodeResp
+	38, // 38: Auth.practice.AuthService.Register:output_type -> Auth.practice.RegisterResp
+	39, // 39: Auth.practice.AuthService.Login:output_type -> Auth.practice.LoginResp
+	40, // 40: Auth.practice.AuthService.GetUserInfo:output_type -> Auth.practice.GetUserInfoResp
+	41, // 41: Auth.practice.AuthService.KickUser:output_type -> Auth.practice.KickUserResp
+	42, // 42: Auth.practice.AuthService.RefreshToken:output_type -> Auth.practice.RefreshTokenResp
+	43, // 43: Auth.practice.AuthService.Logout:output_type -> Auth.practice.LogoutResp
+	44, // 44: Auth.practice.AuthService.LogoutAll:output_type -> Auth.practice.LogoutAllResp
+	45, // 45: Auth.practice.AuthService.ListSessions:output_type -> Auth.practice.ListSessionsResp
+	46, // 46: Auth.practice.AuthService.RevokeSession:output_type -> Auth.practice.RevokeSessionResp
+	47, // 47: Auth.practice.AuthService.CreateClient:output_type -> Auth.practice.CreateClientResp
+	48, // 48: Auth.practice.AuthService.ListClients:output_type -> Auth.practice.ListClientsResp
+	49, // 49: Auth.practice.AuthService.DeleteClient:output_type -> Auth.practice.DeleteClientResp
+	50, // 50: Auth.practice.AuthService.CreateServiceAccount:output_type -> Auth.practice.CreateServiceAccountResp
+	51, // 51: Auth.practice.AuthService.ListServiceAccounts:output_type -> Auth.practice.ListServiceAccountsResp
+	52, // 52: Auth.practice.AuthService.RotateServiceAccountSecret:output_type -> Auth.practice.RotateServiceAccountSecretResp
+	53, // 53: Auth.practice.AuthService.SetServiceAccountStatus:output_type -> Auth.practice.SetServiceAccountStatusResp
+	54, // 54: Auth.practice.AuthService.ForgotPassword:output_type -> Auth.practice.ForgotPasswordResp
+	55, // 55: Auth.practice.AuthService.ResetPassword:output_type -> Auth.practice.ResetPasswordResp
+	56, // 56: Auth.practice.AuthService.ChangePassword:output_type -> Auth.practice.ChangePasswordResp
+	57, // 57: Auth.practice.AuthService.SendLoginCode:output_type -> Auth.practice.SendLoginCodeResp
+	58, // 58: Auth.practice.AuthService.LoginWithCode:output_type -> Auth.practice.LoginWithCodeResp
+	59, // 59: Auth.practice.AuthService.SendMagicLink:output_type -> Auth.practice.SendMagicLinkResp
+	60, // 60: Auth.practice.AuthService.ConsumeMagicLink:output_type -> Auth.practice.ConsumeMagicLinkResp
+	61, // 61: Auth.practice.AuthService.EnrollTOTP:output_type -> Auth.practice.EnrollTOTPResp
+	62, // 62: Auth.practice.AuthService.ConfirmTOTP:output_type -> Auth.practice.ConfirmTOTPResp
+	63, // 63: Auth.practice.AuthService.DisableTOTP:output_type -> Auth.practice.DisableTOTPResp
+	64, // 64: Auth.practice.AuthService.LoginWithMFA:output_type -> Auth.practice.LoginWithMFAResp
+	65, // 65: Auth.practice.AuthService.ListPasskeys:output_type -> Auth.practice.ListPasskeysResp
+	66, // 66: Auth.practice.AuthService.DeletePasskey:output_type -> Auth.practice.DeletePasskeyResp
+	67, // 67: Auth.practice.AuthService.RegenerateRecoveryCodes:output_type -> Auth.practice.RegenerateRecoveryCodesResp
+	68, // 68: Auth.practice.AuthService.ListIdentities:output_type -> Auth.practice.ListIdentitiesResp
+	69, // 69: Auth.practice.AuthService.SendIdentityCode:output_type -> Auth.practice.SendIdentityCodeResp
+	70, // 70: Auth.practice.AuthService.AttachIdentity:output_type -> Auth.practice.AttachIdentityResp
+	71, // 71: Auth.practice.AuthService.DetachIdentity:output_type -> Auth.practice.DetachIdentityResp
+	36, // [36:72] is the sub-list for method output_type
+	0,  // [0:36] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
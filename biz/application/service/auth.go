@@ -2,19 +2,27 @@ package service
 
 import (
 	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
 	"auth/biz/infrastructure/consts"
 	"auth/biz/infrastructure/email"
 	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/mapper/client"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/passkey"
+	"auth/biz/infrastructure/mapper/serviceaccount"
+	"auth/biz/infrastructure/mapper/session"
 	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/sms"
 	"auth/biz/infrastructure/util"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"golang.org/x/crypto/bcrypt"
 )
 
 // AuthService 身份验证服务接口
@@ -24,31 +32,141 @@ type AuthService interface {
 	// VerifyCode 验证验证码
 	VerifyCode(ctx context.Context, req *Practice.VerifyCodeReq) (*Practice.VerifyCodeResp, error)
 	// Register 用户注册
-	Register(ctx context.Context, req *Practice.RegisterReq) (*Practice.RegisterResp, error)
+	Register(ctx context.Context, req *Practice.RegisterReq, clientIP string, userAgent string) (*Practice.RegisterResp, error)
 	// Login 用户登录
-	Login(ctx context.Context, req *Practice.LoginReq, clientIP string) (*Practice.LoginResp, error)
+	Login(ctx context.Context, req *Practice.LoginReq, clientIP string, userAgent string) (*Practice.LoginResp, error)
 	// GetUserInfo 获取用户信息
 	GetUserInfo(ctx context.Context, userID string, userEmail string) (*Practice.GetUserInfoResp, error)
 	// KickUser 踢出用户
 	KickUser(ctx context.Context, req *Practice.KickUserReq, currentUserID string) (*Practice.KickUserResp, error)
+	// RefreshToken 刷新令牌
+	RefreshToken(ctx context.Context, req *Practice.RefreshTokenReq) (*Practice.RefreshTokenResp, error)
+	// Logout 退出登录，吊销当前token及其会话
+	Logout(ctx context.Context, req *Practice.LogoutReq, current *CurrentToken) (*Practice.LogoutResp, error)
+	// LogoutAll 退出所有会话，吊销当前用户的全部token
+	LogoutAll(ctx context.Context, req *Practice.LogoutAllReq, current *CurrentToken) (*Practice.LogoutAllResp, error)
+	// ListSessions 获取当前用户的登录会话列表
+	ListSessions(ctx context.Context, req *Practice.ListSessionsReq, current *CurrentToken) (*Practice.ListSessionsResp, error)
+	// RevokeSession 吊销当前用户的指定登录会话
+	RevokeSession(ctx context.Context, req *Practice.RevokeSessionReq, current *CurrentToken) (*Practice.RevokeSessionResp, error)
+	// Introspect 令牌自省（RFC 7662）
+	Introspect(ctx context.Context, req *IntrospectReq) (*IntrospectResp, error)
+	// CreateClient 注册OAuth客户端（管理员功能）
+	CreateClient(ctx context.Context, req *Practice.CreateClientReq, current *CurrentToken) (*Practice.CreateClientResp, error)
+	// ListClients 获取OAuth客户端列表（管理员功能）
+	ListClients(ctx context.Context, req *Practice.ListClientsReq, current *CurrentToken) (*Practice.ListClientsResp, error)
+	// DeleteClient 删除OAuth客户端（管理员功能）
+	DeleteClient(ctx context.Context, req *Practice.DeleteClientReq, current *CurrentToken) (*Practice.DeleteClientResp, error)
+	// PrepareAuthorize 校验OAuth授权请求，返回授权页信息
+	PrepareAuthorize(ctx context.Context, req *AuthorizeReq) (*AuthorizePrompt, error)
+	// Authorize 处理OAuth授权页提交，返回携带授权码的回调地址
+	Authorize(ctx context.Context, req *AuthorizeReq, clientIP string) (string, error)
+	// Token OAuth令牌端点
+	Token(ctx context.Context, req *TokenReq, clientIP string, userAgent string) (*TokenResp, error)
+	// GetOpenIDConfiguration 获取OIDC发现文档
+	GetOpenIDConfiguration(ctx context.Context) (*OpenIDConfiguration, error)
+	// UserInfo OIDC用户信息端点
+	UserInfo(ctx context.Context, current *CurrentToken) (*UserInfoResp, error)
+	// CreateServiceAccount 创建服务账号（管理员功能）
+	CreateServiceAccount(ctx context.Context, req *Practice.CreateServiceAccountReq, current *CurrentToken) (*Practice.CreateServiceAccountResp, error)
+	// ListServiceAccounts 获取服务账号列表（管理员功能）
+	ListServiceAccounts(ctx context.Context, req *Practice.ListServiceAccountsReq, current *CurrentToken) (*Practice.ListServiceAccountsResp, error)
+	// RotateServiceAccountSecret 轮换服务账号密钥（管理员功能）
+	RotateServiceAccountSecret(ctx context.Context, req *Practice.RotateServiceAccountSecretReq, current *CurrentToken) (*Practice.RotateServiceAccountSecretResp, error)
+	// SetServiceAccountStatus 启用或停用服务账号（管理员功能）
+	SetServiceAccountStatus(ctx context.Context, req *Practice.SetServiceAccountStatusReq, current *CurrentToken) (*Practice.SetServiceAccountStatusResp, error)
+	// ForgotPassword 忘记密码，发送重置密码验证码
+	ForgotPassword(ctx context.Context, req *Practice.ForgotPasswordReq) (*Practice.ForgotPasswordResp, error)
+	// ResetPassword 使用验证码重置密码
+	ResetPassword(ctx context.Context, req *Practice.ResetPasswordReq) (*Practice.ResetPasswordResp, error)
+	// ChangePassword 修改当前用户密码
+	ChangePassword(ctx context.Context, req *Practice.ChangePasswordReq, current *CurrentToken, clientIP string) (*Practice.ChangePasswordResp, error)
+	// SendLoginCode 发送登录验证码
+	SendLoginCode(ctx context.Context, req *Practice.SendLoginCodeReq) (*Practice.SendLoginCodeResp, error)
+	// LoginWithCode 使用邮箱或手机号验证码登录
+	LoginWithCode(ctx context.Context, req *Practice.LoginWithCodeReq, clientIP string, userAgent string) (*Practice.LoginWithCodeResp, error)
+	// SendMagicLink 发送绑定当前浏览器的登录链接
+	SendMagicLink(ctx context.Context, req *Practice.SendMagicLinkReq, browserNonce string) (*Practice.SendMagicLinkResp, error)
+	// ConsumeMagicLink 使用登录链接登录
+	ConsumeMagicLink(ctx context.Context, req *Practice.ConsumeMagicLinkReq, browserNonce string, clientIP string, userAgent string) (*Practice.ConsumeMagicLinkResp, error)
+	// EnrollTOTP 开始绑定TOTP两步验证
+	EnrollTOTP(ctx context.Context, req *Practice.EnrollTOTPReq, current *CurrentToken) (*Practice.EnrollTOTPResp, error)
+	// ConfirmTOTP 确认绑定TOTP两步验证
+	ConfirmTOTP(ctx context.Context, req *Practice.ConfirmTOTPReq, current *CurrentToken) (*Practice.ConfirmTOTPResp, error)
+	// DisableTOTP 关闭TOTP两步验证
+	DisableTOTP(ctx context.Context, req *Practice.DisableTOTPReq, current *CurrentToken, clientIP string) (*Practice.DisableTOTPResp, error)
+	// LoginWithMFA 使用mfa token和动态验证码完成登录
+	LoginWithMFA(ctx context.Context, req *Practice.LoginWithMFAReq, clientIP string, userAgent string) (*Practice.LoginWithMFAResp, error)
+	// RegenerateRecoveryCodes 重新生成两步验证恢复码
+	RegenerateRecoveryCodes(ctx context.Context, req *Practice.RegenerateRecoveryCodesReq, current *CurrentToken, clientIP string) (*Practice.RegenerateRecoveryCodesResp, error)
+	// BeginPasskeyRegistration 开始注册通行密钥
+	BeginPasskeyRegistration(ctx context.Context, req *Practice.BeginPasskeyRegistrationReq, current *CurrentToken, clientIP string) (*Practice.BeginPasskeyRegistrationResp, error)
+	// FinishPasskeyRegistration 完成注册通行密钥
+	FinishPasskeyRegistration(ctx context.Context, req *Practice.FinishPasskeyRegistrationReq, current *CurrentToken) (*Practice.FinishPasskeyRegistrationResp, error)
+	// ListPasskeys 获取当前用户的通行密钥列表
+	ListPasskeys(ctx context.Context, req *Practice.ListPasskeysReq, current *CurrentToken) (*Practice.ListPasskeysResp, error)
+	// DeletePasskey 删除当前用户的通行密钥
+	DeletePasskey(ctx context.Context, req *Practice.DeletePasskeyReq, current *CurrentToken) (*Practice.DeletePasskeyResp, error)
+	// BeginPasskeyLogin 开始通行密钥登录
+	BeginPasskeyLogin(ctx context.Context) (*Practice.BeginPasskeyLoginResp, error)
+	// FinishPasskeyLogin 完成通行密钥登录
+	FinishPasskeyLogin(ctx context.Context, req *Practice.FinishPasskeyLoginReq, clientIP string, userAgent string) (*Practice.LoginResp, error)
+	// StartSocialLogin 开始第三方登录
+	StartSocialLogin(ctx context.Context, req *StartSocialLoginReq) (*StartSocialLoginResp, error)
+	// FinishSocialLogin 完成第三方登录
+	FinishSocialLogin(ctx context.Context, req *FinishSocialLoginReq, cookieState string, clientIP string, userAgent string) (*Practice.LoginResp, error)
+	// ListIdentities 获取当前用户绑定的邮箱和手机号
+	ListIdentities(ctx context.Context, req *Practice.ListIdentitiesReq, current *CurrentToken) (*Practice.ListIdentitiesResp, error)
+	// SendIdentityCode 向要绑定的邮箱或手机号发送验证码
+	SendIdentityCode(ctx context.Context, req *Practice.SendIdentityCodeReq, current *CurrentToken) (*Practice.SendIdentityCodeResp, error)
+	// AttachIdentity 为当前用户绑定邮箱或手机号
+	AttachIdentity(ctx context.Context, req *Practice.AttachIdentityReq, current *CurrentToken, clientIP string) (*Practice.AttachIdentityResp, error)
+	// DetachIdentity 解绑当前用户的邮箱或手机号
+	DetachIdentity(ctx context.Context, req *Practice.DetachIdentityReq, current *CurrentToken) (*Practice.DetachIdentityResp, error)
+}
+
+// CurrentToken JWTAuth中间件解析出的当前请求token信息
+type CurrentToken struct {
+	UserID      string
+	Email       string
+	TokenID     string
+	SessionID   string
+	SubjectType string // 主体类型：user-用户，service-服务账号
+	Scope       string
+	ExpireAt    int64
 }
 
 // AuthServiceImpl 身份验证服务实现
 type AuthServiceImpl struct {
-	userDAO user.IUserDAO
+	userDAO           user.IUserDAO
+	sessionDAO        session.ISessionDAO
+	clientDAO         client.IClientDAO
+	serviceAccountDAO serviceaccount.IServiceAccountDAO
+	passkeyDAO        passkey.IPasskeyDAO
+	identityDAO       identity.IIdentityDAO
 }
 
 // NewAuthService 创建身份验证服务实例
 func NewAuthService() AuthService {
 	return &AuthServiceImpl{
-		userDAO: user.NewUserDAO(),
+		userDAO:           user.NewUserDAO(),
+		sessionDAO:        session.NewSessionDAO(),
+		clientDAO:         client.NewClientDAO(),
+		serviceAccountDAO: serviceaccount.NewServiceAccountDAO(),
+		passkeyDAO:        passkey.NewPasskeyDAO(),
+		identityDAO:       identity.NewIdentityDAO(),
 	}
 }
 
-// SendVerificationCode 发送验证码
+// SendVerificationCode 发送验证码，填写邮箱时通过邮件发送，填写手机号时通过短信发送
 func (s *AuthServiceImpl) SendVerificationCode(ctx context.Context, req *Practice.SendVerificationCodeReq) (*Practice.SendVerificationCodeResp, error) {
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查账户是否被冻结
-	isFrozen, err := util.IsAccountFrozen(ctx, req.Email)
+	isFrozen, err := util.IsAccountFrozen(ctx, id.Identifier)
 	if err != nil {
 		fmt.Println("检查账户冻结状态失败:", err)
 		return &Practice.SendVerificationCodeResp{
@@ -67,7 +185,7 @@ func (s *AuthServiceImpl) SendVerificationCode(ctx context.Context, req *Practic
 	}
 
 	// 检查发送频率限制
-	canSend, remainSeconds, err := util.CheckCodeCooldown(ctx, req.Email)
+	canSend, remainSeconds, err := util.CheckCodeCooldown(ctx, id.Identifier)
 	if err != nil {
 		fmt.Println("检查验证码冷却时间失败:", err)
 		return &Practice.SendVerificationCodeResp{
@@ -87,10 +205,10 @@ func (s *AuthServiceImpl) SendVerificationCode(ctx context.Context, req *Practic
 
 	// 生成验证码
 	code := util.GenerateVerificationCode()
-	fmt.Println("生成的验证码:", code, "邮箱:", req.Email)
+	fmt.Println("生成的验证码:", code, "接收方:", id.Identifier)
 
 	// 存储验证码到Redis
-	redisKey := util.GetCodeRedisKey(req.Email)
+	redisKey := util.GetCodeRedisKey(id.Identifier)
 	err = util.SetWithExpire(ctx, redisKey, code, time.Duration(consts.CodeExpire)*time.Second)
 	if err != nil {
 		fmt.Println("Redis存储验证码失败:", err)
@@ -102,14 +220,33 @@ func (s *AuthServiceImpl) SendVerificationCode(ctx context.Context, req *Practic
 	}
 
 	// 设置验证码发送冷却时间
-	err = util.SetCodeCooldown(ctx, req.Email)
+	err = util.SetCodeCooldown(ctx, id.Identifier)
 	if err != nil {
 		fmt.Println("设置验证码冷却时间失败:", err)
 		// 非致命错误，继续流程
 	}
 
+	// 填写手机号时发送验证码短信
+	if id.Type == consts.IdentityTypePhone {
+		err = sms.SendVerificationCode(id.Identifier, code)
+		if err != nil {
+			fmt.Println("发送验证码短信失败:", err)
+			return &Practice.SendVerificationCodeResp{
+				Code:    consts.ErrSystem,
+				Msg:     consts.ErrMsg[consts.ErrSystem],
+				Message: "发送验证码短信失败: " + err.Error(),
+			}, err
+		}
+
+		return &Practice.SendVerificationCodeResp{
+			Code:    consts.Success,
+			Msg:     "验证码发送成功",
+			Message: "验证码已通过短信发送到您的手机，请查收",
+		}, nil
+	}
+
 	// 发送验证码邮件
-	err = email.SendVerificationCode(req.Email, code)
+	err = email.SendVerificationCode(id.Identifier, code)
 	if err != nil {
 		fmt.Println("发送验证码邮件失败:", err)
 		return &Practice.SendVerificationCodeResp{
@@ -129,8 +266,13 @@ func (s *AuthServiceImpl) SendVerificationCode(ctx context.Context, req *Practic
 
 // VerifyCode 验证验证码
 func (s *AuthServiceImpl) VerifyCode(ctx context.Context, req *Practice.VerifyCodeReq) (*Practice.VerifyCodeResp, error) {
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查账户是否被冻结
-	isFrozen, err := util.IsAccountFrozen(ctx, req.Email)
+	isFrozen, err := util.IsAccountFrozen(ctx, id.Identifier)
 	if err != nil {
 		fmt.Println("检查账户冻结状态失败:", err)
 		return &Practice.VerifyCodeResp{
@@ -149,7 +291,7 @@ func (s *AuthServiceImpl) VerifyCode(ctx context.Context, req *Practice.VerifyCo
 	}
 
 	// 从Redis获取验证码
-	redisKey := util.GetCodeRedisKey(req.Email)
+	redisKey := util.GetCodeRedisKey(id.Identifier)
 	storedCode, err := util.Get(ctx, redisKey)
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
@@ -182,7 +324,7 @@ func (s *AuthServiceImpl) VerifyCode(ctx context.Context, req *Practice.VerifyCo
 		resp.Msg = consts.ErrMsg[consts.ErrVerifyCodeInvalid]
 
 		// 增加验证失败次数
-		failCount, err := util.IncreaseCodeFailCount(ctx, req.Email)
+		failCount, err := util.IncreaseCodeFailCount(ctx, id.Identifier)
 		if err != nil {
 			fmt.Println("增加验证码失败次数出错:", err)
 			// 非致命错误，继续流程
@@ -190,7 +332,7 @@ func (s *AuthServiceImpl) VerifyCode(ctx context.Context, req *Practice.VerifyCo
 
 		// 如果失败次数达到上限，冻结账号
 		if failCount >= consts.CodeMaxFailCount {
-			err = util.FreezeAccount(ctx, req.Email)
+			err = util.FreezeAccount(ctx, id.Identifier)
 			if err != nil {
 				fmt.Println("冻结账号失败:", err)
 				// 非致命错误，继续流程
@@ -200,20 +342,50 @@ func (s *AuthServiceImpl) VerifyCode(ctx context.Context, req *Practice.VerifyCo
 			resp.Msg = consts.ErrMsg[consts.ErrAccountFrozen]
 		}
 	} else {
-		// 验证成功后删除验证码，防止重复使用
-		util.Del(ctx, redisKey)
+		// 验证成功后原子地删除验证码，并发请求使用同一验证码时只有一个能删除成功
+		deleted, err := util.CompareAndDelete(ctx, redisKey, req.VerifyCode)
+		if err != nil {
+			fmt.Println("删除验证码失败:", err)
+			return &Practice.VerifyCodeResp{
+				Code:  consts.ErrRedis,
+				Msg:   consts.ErrMsg[consts.ErrRedis],
+				Valid: false,
+			}, err
+		}
+		if !deleted {
+			return &Practice.VerifyCodeResp{
+				Code:  consts.ErrVerifyCodeExpired,
+				Msg:   consts.ErrMsg[consts.ErrVerifyCodeExpired],
+				Valid: false,
+			}, nil
+		}
 
 		// 重置验证失败次数
-		util.ResetCodeFailCount(ctx, req.Email)
+		util.ResetCodeFailCount(ctx, id.Identifier)
 	}
 
 	return resp, nil
 }
 
 // Register 用户注册
-func (s *AuthServiceImpl) Register(ctx context.Context, req *Practice.RegisterReq) (*Practice.RegisterResp, error) {
+func (s *AuthServiceImpl) Register(ctx context.Context, req *Practice.RegisterReq, clientIP string, userAgent string) (*Practice.RegisterResp, error) {
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目录用户首次使用目录密码登录时自动创建，不能注册本地密码
+	if err = checkDirectoryIdentity(id); err != nil {
+		return nil, err
+	}
+
+	// 校验密码策略，放在校验验证码之前，避免验证码被消耗
+	if err = checkPasswordPolicy(req.Password, id.Identifier); err != nil {
+		return nil, err
+	}
+
 	// 检查账户是否被冻结
-	isFrozen, err := util.IsAccountFrozen(ctx, req.Email)
+	isFrozen, err := util.IsAccountFrozen(ctx, id.Identifier)
 	if err != nil {
 		fmt.Println("检查账户冻结状态失败:", err)
 		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
@@ -226,6 +398,7 @@ func (s *AuthServiceImpl) Register(ctx context.Context, req *Practice.RegisterRe
 	// 验证验证码
 	verifyReq := &Practice.VerifyCodeReq{
 		Email:      req.Email,
+		Phone:      req.Phone,
 		VerifyCode: req.VerifyCode,
 	}
 	verifyResp, err := s.VerifyCode(ctx, verifyReq)
@@ -254,25 +427,29 @@ func (s *AuthServiceImpl) Register(ctx context.Context, req *Practice.RegisterRe
 	mongoCtx, cancel := util.CreateContext()
 	defer cancel()
 
-	existingUser, err := s.userDAO.FindByEmail(mongoCtx, req.Email)
+	existingIdentity, err := s.findIdentity(mongoCtx, id)
 	if err != nil {
 		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
 	}
 
-	if existingUser != nil {
+	if existingIdentity != nil {
 		return nil, consts.NewAppErrorWithCode(consts.ErrUserAlreadyExist)
 	}
 
 	// 密码加密
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPassword, err := hashPassword(req.Password)
 	if err != nil {
-		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+		return nil, err
 	}
 
 	// 创建用户
 	newUser := &user.User{
-		Email:    req.Email,
-		Password: string(hashedPassword),
+		Password: hashedPassword,
+	}
+	if id.Type == consts.IdentityTypePhone {
+		newUser.Phone = id.Identifier
+	} else {
+		newUser.Email = id.Identifier
 	}
 
 	err = s.userDAO.Create(mongoCtx, newUser)
@@ -280,94 +457,200 @@ func (s *AuthServiceImpl) Register(ctx context.Context, req *Practice.RegisterRe
 		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
 	}
 
-	// 生成JWT令牌
-	token, expire, err := jwt.GenerateToken(newUser.ID.Hex(), newUser.Email)
+	// 写入登录标识，并发注册同一邮箱或手机号时由唯一索引保证只有一个成功
+	err = s.identityDAO.Create(mongoCtx, &identity.Identity{
+		UserID:     newUser.ID,
+		Type:       id.Type,
+		Identifier: id.Identifier,
+		Verified:   true,
+	})
+	if errors.Is(err, identity.ErrDuplicate) {
+		if err = s.userDAO.Delete(mongoCtx, newUser.ID); err != nil {
+			fmt.Println("删除重复注册的用户失败:", err)
+		}
+		return nil, consts.NewAppErrorWithCode(consts.ErrUserAlreadyExist)
+	}
 	if err != nil {
-		return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+		// 用户记录中已保存邮箱或手机号，首次查找时会补建登录标识，不影响注册
+		fmt.Println("创建登录标识失败:", err)
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, newUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    newUser.ID.Hex(),
+		Email:     newUser.Email,
+		SessionID: sessionID,
+		Scope:     consts.DefaultLoginScope,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 返回成功响应
 	return &Practice.RegisterResp{
-		Token:        token,
-		AccessExpire: expire,
+		Token:         tokens.AccessToken,
+		AccessExpire:  tokens.AccessExpire,
+		RefreshToken:  tokens.RefreshToken,
+		RefreshExpire: tokens.RefreshExpire,
 	}, nil
 }
 
 // Login 用户登录
-func (s *AuthServiceImpl) Login(ctx context.Context, req *Practice.LoginReq, clientIP string) (*Practice.LoginResp, error) {
-	// 检查邮箱是否被锁定
-	isEmailLocked, err := util.IsLoginLockedByEmail(ctx, req.Email)
+func (s *AuthServiceImpl) Login(ctx context.Context, req *Practice.LoginReq, clientIP string, userAgent string) (*Practice.LoginResp, error) {
+	// 校验申请的授权范围
+	scope, err := resolveLoginScope(req.Scope)
 	if err != nil {
-		fmt.Println("检查邮箱锁定状态失败:", err)
-		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		return nil, err
 	}
 
-	if isEmailLocked {
-		fmt.Println("邮箱已被锁定:", req.Email)
-		return nil, consts.NewAppErrorWithCode(consts.ErrLoginLocked)
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
 	}
 
-	// 检查IP是否被锁定
-	isIPLocked, err := util.IsLoginLockedByIP(ctx, clientIP)
+	// 校验账号密码
+	foundUser, err := s.authenticate(ctx, id, req.Password, clientIP)
 	if err != nil {
-		fmt.Println("检查IP锁定状态失败:", err)
-		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		return nil, err
 	}
 
-	if isIPLocked {
-		fmt.Println("IP已被锁定:", clientIP)
-		return nil, consts.NewAppErrorWithCode(consts.ErrLoginLocked)
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	// 检查密码是否已泄露，泄露则提示用户修改密码
+	passwordResetRequired := s.flagBreachedPassword(mongoCtx, foundUser, req.Password)
+
+	// 已开启两步验证时只返回mfa token
+	if foundUser.TOTPEnabled {
+		challenge, err := s.startMFAChallenge(ctx, foundUser, scope)
+		if err != nil {
+			return nil, err
+		}
+		return &Practice.LoginResp{
+			Scope:                 scope,
+			PasswordResetRequired: passwordResetRequired,
+			MfaRequired:           true,
+			MfaToken:              challenge.Token,
+			MfaExpire:             challenge.Expire,
+		}, nil
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, foundUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    foundUser.ID.Hex(),
+		Email:     foundUser.Email,
+		SessionID: sessionID,
+		Scope:     scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.LoginResp{
+		AccessToken:           tokens.AccessToken,
+		AccessExpire:          tokens.AccessExpire,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshExpire:         tokens.RefreshExpire,
+		Scope:                 scope,
+		PasswordResetRequired: passwordResetRequired,
+	}, nil
+}
+
+// resolveLoginScope 校验登录申请的授权范围，只能是第一方授权范围的子集，省略时授予全部
+// 例如给只读看板签发只有auth:read的token
+func resolveLoginScope(requested string) (string, error) {
+	scopes := strings.Fields(requested)
+	if len(scopes) == 0 {
+		return consts.DefaultLoginScope, nil
+	}
+
+	allowed := strings.Fields(consts.DefaultLoginScope)
+	granted := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(allowed, scope) {
+			return "", consts.NewAppError(consts.ErrParams, "授权范围无效: "+scope)
+		}
+		if !slices.Contains(granted, scope) {
+			granted = append(granted, scope)
+		}
+	}
+	return strings.Join(granted, " "), nil
+}
+
+// authenticate 校验邮箱或手机号和密码，包含登录锁定检查和失败计数，Login与OAuth授权页共用
+func (s *AuthServiceImpl) authenticate(ctx context.Context, id *loginIdentity, password string, clientIP string) (*user.User, error) {
+	// 检查邮箱或手机号和IP是否被锁定
+	if err := checkLoginLocked(ctx, id.Identifier, clientIP); err != nil {
+		return nil, err
 	}
 
 	// 查找用户
 	mongoCtx, cancel := util.CreateContext()
 	defer cancel()
 
-	foundUser, err := s.userDAO.FindByEmail(mongoCtx, req.Email)
+	foundUser, err := s.findUserByIdentity(mongoCtx, id)
 	if err != nil {
 		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
 	}
 
-	// 用户不存在或密码错误时，返回统一的错误信息
-	if foundUser == nil {
-		// 用户不存在，只增加IP维度的失败次数
-		util.HandleLoginFailForNonExistentUser(ctx, clientIP)
-		// 返回统一的错误信息：账号或密码错误
-		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	// 使用非主邮箱或手机号登录时，检查账号是否被锁定
+	if foundUser != nil {
+		if err = checkAccountLocked(ctx, foundUser, id, clientIP); err != nil {
+			return nil, err
+		}
 	}
 
-	// 验证密码
-	err = bcrypt.CompareHashAndPassword([]byte(foundUser.Password), []byte(req.Password))
+	// 按用户或邮箱域名选择本地密码或LDAP目录校验密码
+	// 用户不存在或密码错误时返回统一的错误信息：账号或密码错误
+	return s.credentialVerifierFor(foundUser, id).verify(ctx, foundUser, id, password, clientIP)
+}
+
+// checkLoginLocked 检查登录标识或IP是否因登录失败次数过多被锁定
+// identifier为邮箱或E.164格式的手机号，已知用户时传入user.LoginIdentifier()
+func checkLoginLocked(ctx context.Context, identifier string, clientIP string) error {
+	// 检查邮箱或手机号是否被锁定
+	isEmailLocked, err := util.IsLoginLockedByEmail(ctx, identifier)
 	if err != nil {
-		// 密码错误，同时增加邮箱和IP维度的失败计数
-		util.HandleLoginFail(ctx, req.Email, clientIP)
-		// 返回统一的错误信息：账号或密码错误
-		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+		fmt.Println("检查邮箱锁定状态失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
 	}
 
-	// 登录成功，重置失败计数
-	go func() {
-		util.ResetLoginFailEmailCount(context.Background(), req.Email)
-		util.ResetLoginFailIPCount(context.Background(), clientIP)
-	}()
+	if isEmailLocked {
+		fmt.Println("账号已被锁定:", identifier)
+		return consts.NewAppErrorWithCode(consts.ErrLoginLocked)
+	}
 
-	// 生成JWT令牌
-	token, expire, err := jwt.GenerateToken(foundUser.ID.Hex(), foundUser.Email)
+	// 检查IP是否被锁定
+	isIPLocked, err := util.IsLoginLockedByIP(ctx, clientIP)
 	if err != nil {
-		return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+		fmt.Println("检查IP锁定状态失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
 	}
 
-	// 返回成功响应
-	return &Practice.LoginResp{
-		AccessToken:  token,
-		AccessExpire: expire,
-	}, nil
+	if isIPLocked {
+		fmt.Println("IP已被锁定:", clientIP)
+		return consts.NewAppErrorWithCode(consts.ErrLoginLocked)
+	}
+	return nil
 }
 
 // GetUserInfo 获取用户信息
 func (s *AuthServiceImpl) GetUserInfo(ctx context.Context, userID string, userEmail string) (*Practice.GetUserInfoResp, error) {
-	// 如果没有用户信息，表示未认证
-	if userID == "" || userEmail == "" {
+	// 如果没有用户信息，表示未认证；使用手机号注册的用户没有邮箱
+	if userID == "" {
 		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
 	}
 
@@ -394,11 +677,14 @@ func (s *AuthServiceImpl) GetUserInfo(ctx context.Context, userID string, userEm
 
 	// 返回成功响应
 	return &Practice.GetUserInfoResp{
-		Code:       consts.Success,
-		Msg:        "获取用户信息成功",
-		Id:         idAsInt64, // 将ObjectID转换为int64
-		Email:      foundUser.Email,
-		CreateTime: foundUser.CreateTime.Unix(),
+		Code:                   consts.Success,
+		Msg:                    "获取用户信息成功",
+		Id:                     idAsInt64, // 将ObjectID转换为int64
+		Email:                  foundUser.Email,
+		Phone:                  foundUser.Phone,
+		CreateTime:             foundUser.CreateTime.Unix(),
+		MfaEnabled:             foundUser.TOTPEnabled,
+		RecoveryCodesRemaining: int64(len(foundUser.RecoveryCodes)),
 	}, nil
 }
 
@@ -456,16 +742,26 @@ func (s *AuthServiceImpl) KickUser(ctx context.Context, req *Practice.KickUserRe
 		}, nil
 	}
 
-	// 将用户token加入黑名单
-	err = util.AddTokenToBlacklist(ctx, targetUser.ID.Hex())
+	// 只踢出指定会话
+	if req.SessionId != "" {
+		return s.kickSession(mongoCtx, targetUser, req.SessionId)
+	}
+
+	// 吊销用户此前签发的所有token，重新登录后签发的token不受影响
+	err = util.RevokeUserTokensBefore(ctx, targetUser.ID.Hex(), time.Now())
 	if err != nil {
 		return &Practice.KickUserResp{
 			Code:    consts.ErrRedis,
 			Msg:     consts.ErrMsg[consts.ErrRedis],
-			Message: "将用户token加入黑名单失败",
+			Message: "吊销用户token失败",
 		}, nil
 	}
 
+	// 同步吊销用户的所有会话记录
+	if err = s.revokeUserSessions(mongoCtx, targetUser.ID); err != nil {
+		return nil, err
+	}
+
 	// 返回成功响应
 	return &Practice.KickUserResp{
 		Code:    consts.Success,
@@ -473,3 +769,267 @@ func (s *AuthServiceImpl) KickUser(ctx context.Context, req *Practice.KickUserRe
 		Message: "用户已被踢出系统，该用户需要重新登录",
 	}, nil
 }
+
+// RefreshToken 刷新令牌
+// 每个refresh token只能使用一次，使用后轮换为新的token；已轮换的token被再次使用时，
+// 视为token泄露，吊销整个token族，迫使该登录会话重新登录
+func (s *AuthServiceImpl) RefreshToken(ctx context.Context, req *Practice.RefreshTokenReq) (*Practice.RefreshTokenResp, error) {
+	// 该接口只用于第一方登录签发的refresh token，OAuth客户端的token需通过/oauth/token刷新
+	tokens, _, err := s.rotateRefreshToken(ctx, req.RefreshToken, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.RefreshTokenResp{
+		AccessToken:   tokens.AccessToken,
+		AccessExpire:  tokens.AccessExpire,
+		RefreshToken:  tokens.RefreshToken,
+		RefreshExpire: tokens.RefreshExpire,
+	}, nil
+}
+
+// rotateRefreshToken 消费refresh token并在同一token族内签发新令牌
+// clientID必须与签发该token的客户端一致，第一方登录签发的token对应空字符串
+func (s *AuthServiceImpl) rotateRefreshToken(ctx context.Context, refreshToken string, clientID string) (*tokenPair, *util.RefreshTokenData, error) {
+	if refreshToken == "" {
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRefreshInvalid)
+	}
+
+	// 取出并删除refresh token，只允许签发该token的客户端刷新，防止绕过客户端认证
+	// 客户端不一致时不消费token，其他客户端不能借此让合法持有者的token失效
+	data, err := util.ConsumeRefreshToken(ctx, refreshToken, clientID)
+	if errors.Is(err, util.ErrRefreshTokenClient) {
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRefreshInvalid)
+	}
+	if err != nil {
+		fmt.Println("读取refresh token失败:", err)
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if data == nil {
+		// token不存在，检查是否为已轮换token的重放
+		familyID, err := util.GetUsedRefreshTokenFamily(ctx, refreshToken)
+		if err != nil {
+			fmt.Println("检查refresh token重放失败:", err)
+			return nil, nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		}
+
+		if familyID == "" {
+			return nil, nil, consts.NewAppErrorWithCode(consts.ErrRefreshInvalid)
+		}
+
+		// 重放已轮换的token，吊销整个token族
+		fmt.Println("检测到refresh token重放，吊销token族:", familyID)
+		if err = util.RevokeRefreshFamily(ctx, familyID); err != nil {
+			fmt.Println("吊销refresh token族失败:", err)
+			return nil, nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		}
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRefreshReused)
+	}
+
+	// 检查token族是否已被吊销
+	revoked, err := util.IsRefreshFamilyRevoked(ctx, data.FamilyID)
+	if err != nil {
+		fmt.Println("检查refresh token族状态失败:", err)
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if revoked {
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRefreshInvalid)
+	}
+
+	// 检查用户的token是否已被整体吊销
+	revokeTime, err := util.GetUserTokensRevokeTime(ctx, data.UserID, data.FamilyID)
+	if err != nil {
+		fmt.Println("检查用户token吊销状态失败:", err)
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if util.IssuedBeforeRevoke(util.TokenIssuedAtMilli(data.IssuedAt, data.IssuedAtMilli), revokeTime) {
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRefreshInvalid)
+	}
+
+	// 记录已轮换的token，用于检测重放
+	if err = util.MarkRefreshTokenUsed(ctx, refreshToken, data); err != nil {
+		fmt.Println("记录已轮换refresh token失败:", err)
+		return nil, nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	// 引入授权范围之前登录签发的refresh token没有scope，刷新时按登录默认值补齐
+	if data.ClientID == "" && data.Scope == "" {
+		data.Scope = consts.DefaultLoginScope
+	}
+
+	// 在同一token族内签发新令牌，token族ID即会话ID
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    data.UserID,
+		Email:     data.Email,
+		SessionID: data.FamilyID,
+		ClientID:  data.ClientID,
+		Scope:     data.Scope,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 更新会话最近活跃时间
+	if sessionID, err := primitive.ObjectIDFromHex(data.FamilyID); err == nil {
+		mongoCtx, cancel := util.CreateContext()
+		defer cancel()
+		if err = s.sessionDAO.Touch(mongoCtx, sessionID, time.Unix(tokens.RefreshExpire, 0)); err != nil {
+			fmt.Println("更新会话活跃时间失败:", err)
+			// 非致命错误，继续流程
+		}
+	}
+
+	return tokens, data, nil
+}
+
+// Logout 退出登录
+func (s *AuthServiceImpl) Logout(ctx context.Context, req *Practice.LogoutReq, current *CurrentToken) (*Practice.LogoutResp, error) {
+	// 验证当前用户是否已认证
+	if current.UserID == "" || current.TokenID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+
+	// 将当前token加入黑名单
+	if err := util.AddTokenToBlacklist(ctx, current.TokenID, current.ExpireAt); err != nil {
+		fmt.Println("将token加入黑名单失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	// 吊销当前会话，会话的refresh token族随之失效
+	if current.SessionID != "" {
+		sessionID, err := primitive.ObjectIDFromHex(current.SessionID)
+		if err != nil {
+			return nil, consts.NewAppErrorWithCode(consts.ErrTokenInvalid)
+		}
+
+		mongoCtx, cancel := util.CreateContext()
+		defer cancel()
+		if err = s.revokeSession(mongoCtx, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 同时吊销传入的refresh token族，兼容不带会话的旧token
+	if req.RefreshToken != "" {
+		// 只读取不消费，属于其他用户的token保持可用
+		data, err := util.GetRefreshToken(ctx, req.RefreshToken)
+		if err != nil {
+			fmt.Println("读取refresh token失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		}
+
+		// 只允许吊销属于自己的refresh token
+		if data != nil && data.UserID == current.UserID {
+			if err = util.RevokeRefreshFamily(ctx, data.FamilyID); err != nil {
+				fmt.Println("吊销refresh token族失败:", err)
+				return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+			}
+		}
+	}
+
+	return &Practice.LogoutResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "已退出登录",
+	}, nil
+}
+
+// LogoutAll 退出所有会话
+func (s *AuthServiceImpl) LogoutAll(ctx context.Context, req *Practice.LogoutAllReq, current *CurrentToken) (*Practice.LogoutAllResp, error) {
+	// 验证当前用户是否已认证
+	if current.UserID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+
+	userID, err := primitive.ObjectIDFromHex(current.UserID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	// 吊销此前签发的所有token，包括各个会话的refresh token
+	if err = util.RevokeUserTokensBefore(ctx, current.UserID, time.Now()); err != nil {
+		fmt.Println("吊销用户token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	// 同步吊销所有会话记录
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+	if err = s.revokeUserSessions(mongoCtx, userID); err != nil {
+		return nil, err
+	}
+
+	return &Practice.LogoutAllResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "已退出所有设备上的登录",
+	}, nil
+}
+
+// tokenPair 一次签发的access token与refresh token
+type tokenPair struct {
+	AccessToken   string
+	AccessExpire  int64
+	RefreshToken  string
+	RefreshExpire int64
+	IDToken       string // 只在OIDC授权码换取令牌时签发
+}
+
+// tokenGrant 签发令牌所需的授权信息
+type tokenGrant struct {
+	UserID    string
+	Email     string
+	SessionID string // 会话ID，同时作为refresh token族ID
+	ClientID  string // 通过OAuth客户端签发时为客户端ID
+	Scope     string // 授权范围，空格分隔
+}
+
+// issueTokens 为会话签发access token与refresh token，会话ID同时作为refresh token族ID
+func (s *AuthServiceImpl) issueTokens(ctx context.Context, grant *tokenGrant) (*tokenPair, error) {
+	// 生成JWT令牌
+	accessToken, accessExpire, err := jwt.GenerateToken(jwt.Claims{
+		UserId:    grant.UserID,
+		Email:     grant.Email,
+		SessionId: grant.SessionID,
+		SubType:   consts.SubjectTypeUser,
+		ClientId:  grant.ClientID,
+		Scope:     grant.Scope,
+	})
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+	}
+
+	// 生成refresh token
+	refreshToken, err := util.GenerateRefreshToken()
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+	}
+
+	now := time.Now()
+	refreshExpire := now.Add(time.Duration(config.GetConfig().JWT.RefreshExpireTime) * time.Second).Unix()
+	err = util.SaveRefreshToken(ctx, refreshToken, &util.RefreshTokenData{
+		UserID:        grant.UserID,
+		Email:         grant.Email,
+		FamilyID:      grant.SessionID,
+		ClientID:      grant.ClientID,
+		Scope:         grant.Scope,
+		IssuedAt:      now.Unix(),
+		ExpireAt:      refreshExpire,
+		IssuedAtMilli: now.UnixMilli(),
+	})
+	if err != nil {
+		fmt.Println("存储refresh token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return &tokenPair{
+		AccessToken:   accessToken,
+		AccessExpire:  accessExpire,
+		RefreshToken:  refreshToken,
+		RefreshExpire: refreshExpire,
+	}, nil
+}
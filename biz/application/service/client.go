@@ -0,0 +1,193 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/client"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateClient 注册OAuth客户端（管理员功能）
+// 机密客户端的client_secret只在注册时返回一次，库中只保存其bcrypt摘要
+func (s *AuthServiceImpl) CreateClient(ctx context.Context, req *Practice.CreateClientReq, current *CurrentToken) (*Practice.CreateClientResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	creatorID, err := s.requireAdmin(mongoCtx, current.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验参数
+	name := strings.TrimSpace(req.Name)
+	if name == "" || (req.Type != consts.ClientTypePublic && req.Type != consts.ClientTypeConfidential) {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+	if len(req.RedirectUris) == 0 {
+		return nil, consts.NewAppError(consts.ErrParams, "至少需要配置一个回调地址")
+	}
+	for _, uri := range req.RedirectUris {
+		if !isValidRedirectURI(uri) {
+			return nil, consts.NewAppError(consts.ErrParams, "回调地址无效: "+uri)
+		}
+	}
+	for _, scope := range req.Scopes {
+		if scope == "" || strings.ContainsAny(scope, " \t\r\n") {
+			return nil, consts.NewAppError(consts.ErrParams, "授权范围无效: "+scope)
+		}
+	}
+
+	// 生成client_id
+	clientID, err := util.GenerateRandomHex(consts.ClientIDBytes)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	newClient := &client.Client{
+		ClientID:     clientID,
+		Name:         name,
+		Type:         req.Type,
+		RedirectURIs: req.RedirectUris,
+		Scopes:       req.Scopes,
+		CreatorID:    creatorID,
+	}
+
+	// 机密客户端生成client_secret
+	var clientSecret string
+	if req.Type == consts.ClientTypeConfidential {
+		clientSecret, newClient.SecretHash, err = generateClientSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.clientDAO.Create(mongoCtx, newClient); err != nil {
+		fmt.Println("创建OAuth客户端失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	return &Practice.CreateClientResp{
+		Code:         consts.Success,
+		Msg:          "注册客户端成功",
+		Client:       toClientInfo(newClient),
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+// ListClients 获取OAuth客户端列表（管理员功能）
+func (s *AuthServiceImpl) ListClients(ctx context.Context, req *Practice.ListClientsReq, current *CurrentToken) (*Practice.ListClientsResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if _, err := s.requireAdmin(mongoCtx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	clients, err := s.clientDAO.FindAll(mongoCtx)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 转换为响应结构
+	infos := make([]*Practice.ClientInfo, 0, len(clients))
+	for _, item := range clients {
+		infos = append(infos, toClientInfo(item))
+	}
+
+	return &Practice.ListClientsResp{
+		Code:    consts.Success,
+		Msg:     "获取客户端列表成功",
+		Clients: infos,
+	}, nil
+}
+
+// DeleteClient 删除OAuth客户端（管理员功能）
+// 删除后该客户端无法再换取或刷新token，已签发的access token在过期前仍然有效
+func (s *AuthServiceImpl) DeleteClient(ctx context.Context, req *Practice.DeleteClientReq, current *CurrentToken) (*Practice.DeleteClientResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if _, err := s.requireAdmin(mongoCtx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	found, err := s.clientDAO.Delete(mongoCtx, req.ClientId)
+	if err != nil {
+		fmt.Println("删除OAuth客户端失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if !found {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	return &Practice.DeleteClientResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "客户端已删除",
+	}, nil
+}
+
+// requireAdmin 校验当前用户为管理员，返回其用户ID
+func (s *AuthServiceImpl) requireAdmin(ctx context.Context, currentUserID string) (primitive.ObjectID, error) {
+	// 验证当前用户是否已认证
+	if currentUserID == "" {
+		return primitive.NilObjectID, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+
+	userID, err := primitive.ObjectIDFromHex(currentUserID)
+	if err != nil {
+		return primitive.NilObjectID, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	isAdmin, err := s.userDAO.CheckIsAdmin(ctx, userID)
+	if err != nil {
+		return primitive.NilObjectID, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if !isAdmin {
+		return primitive.NilObjectID, consts.NewAppErrorWithCode(consts.ErrPermissionDenied)
+	}
+	return userID, nil
+}
+
+// isValidRedirectURI 回调地址必须是不带fragment的绝对地址，只有本机回环地址允许使用http
+func isValidRedirectURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Host == "" || parsed.Fragment != "" {
+		return false
+	}
+
+	switch parsed.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := parsed.Hostname()
+		if host == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(host)
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
+// toClientInfo 转换为响应结构
+func toClientInfo(item *client.Client) *Practice.ClientInfo {
+	return &Practice.ClientInfo{
+		ClientId:     item.ClientID,
+		Name:         item.Name,
+		Type:         item.Type,
+		RedirectUris: item.RedirectURIs,
+		Scopes:       item.Scopes,
+		CreateTime:   item.CreateTime.Unix(),
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"time"
+)
+
+// sendPurposeCode 向已注册的邮箱或手机号发送指定用途的验证码，与SendVerificationCode共用冷却和冻结限制
+// 未注册时不发送但同样返回成功，调用方应返回统一的提示，避免暴露账号是否存在
+func (s *AuthServiceImpl) sendPurposeCode(ctx context.Context, purpose string, id *loginIdentity, send func(to, code string) error) error {
+	foundUser, err := s.prepareCodeSend(ctx, id)
+	if err != nil || foundUser == nil {
+		return err
+	}
+	return deliverPurposeCode(ctx, purpose, id, send)
+}
+
+// deliverPurposeCode 生成指定用途的验证码，按用途存储后发送，调用方负责冻结和冷却检查
+func deliverPurposeCode(ctx context.Context, purpose string, id *loginIdentity, send func(to, code string) error) error {
+	// 生成验证码并按用途存储
+	code := util.GenerateVerificationCode()
+	redisKey := util.GetPurposeCodeRedisKey(purpose, id.Identifier)
+	err := util.SetWithExpire(ctx, redisKey, code, time.Duration(consts.CodeExpire)*time.Second)
+	if err != nil {
+		fmt.Println("Redis存储验证码失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	// 发送验证码邮件或短信
+	if err = send(id.Identifier, code); err != nil {
+		fmt.Println("发送验证码失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+	return nil
+}
+
+// prepareCodeSend 检查冻结和发送冷却，查找邮箱或手机号对应的用户并设置冷却时间
+// 未注册时返回nil用户，同样设置冷却时间，保持两种情况的行为一致
+func (s *AuthServiceImpl) prepareCodeSend(ctx context.Context, id *loginIdentity) (*user.User, error) {
+	// 检查账户是否被冻结
+	isFrozen, err := util.IsAccountFrozen(ctx, id.Identifier)
+	if err != nil {
+		fmt.Println("检查账户冻结状态失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if isFrozen {
+		return nil, consts.NewAppErrorWithCode(consts.ErrAccountFrozen)
+	}
+
+	// 检查发送频率限制
+	canSend, remainSeconds, err := util.CheckCodeCooldown(ctx, id.Identifier)
+	if err != nil {
+		fmt.Println("检查验证码冷却时间失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if !canSend {
+		return nil, consts.NewAppError(consts.ErrCodeTooFrequent, fmt.Sprintf("验证码发送过于频繁，请等待%d秒后再试", remainSeconds))
+	}
+
+	// 查找用户
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.findUserByIdentity(mongoCtx, id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 无论是否注册都设置冷却时间，保持两种情况的行为一致
+	if err = util.SetCodeCooldown(ctx, id.Identifier); err != nil {
+		fmt.Println("设置验证码冷却时间失败:", err)
+		// 非致命错误，继续流程
+	}
+
+	return foundUser, nil
+}
+
+// verifyPurposeCode 校验指定用途的验证码，与VerifyCode共用失败计数和冻结规则
+// 校验成功后删除验证码，防止重复使用
+func verifyPurposeCode(ctx context.Context, purpose, identifier, code string) error {
+	// 检查账户是否被冻结
+	isFrozen, err := util.IsAccountFrozen(ctx, identifier)
+	if err != nil {
+		fmt.Println("检查账户冻结状态失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if isFrozen {
+		return consts.NewAppErrorWithCode(consts.ErrAccountFrozen)
+	}
+
+	// 从Redis获取验证码
+	redisKey := util.GetPurposeCodeRedisKey(purpose, identifier)
+	storedCode, err := util.Get(ctx, redisKey)
+	if err != nil {
+		if util.IsRedisNil(err) {
+			return consts.NewAppErrorWithCode(consts.ErrVerifyCodeExpired)
+		}
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if storedCode != code {
+		// 增加验证失败次数
+		failCount, err := util.IncreaseCodeFailCount(ctx, identifier)
+		if err != nil {
+			fmt.Println("增加验证码失败次数出错:", err)
+			// 非致命错误，继续流程
+		}
+
+		// 如果失败次数达到上限，冻结账号并作废验证码
+		if failCount >= consts.CodeMaxFailCount {
+			if err = util.FreezeAccount(ctx, identifier); err != nil {
+				fmt.Println("冻结账号失败:", err)
+				// 非致命错误，继续流程
+			}
+			util.Del(ctx, redisKey)
+			return consts.NewAppErrorWithCode(consts.ErrAccountFrozen)
+		}
+		return consts.NewAppErrorWithCode(consts.ErrVerifyCodeInvalid)
+	}
+
+	// 验证成功后原子地删除验证码，并发请求使用同一验证码时只有一个能删除成功
+	deleted, err := util.CompareAndDelete(ctx, redisKey, code)
+	if err != nil {
+		fmt.Println("删除验证码失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	if !deleted {
+		return consts.NewAppErrorWithCode(consts.ErrVerifyCodeExpired)
+	}
+
+	// 重置验证失败次数
+	util.ResetCodeFailCount(ctx, identifier)
+	return nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestVerifyPurposeCodeUsableOnce(t *testing.T) {
+	newTestService()
+	ctx := context.Background()
+	identifier := "once@example.com"
+	_ = util.SetWithExpire(ctx, util.GetPurposeCodeRedisKey(consts.CodePurposeResetPassword, identifier), "123456", time.Minute)
+
+	// 并发使用同一验证码时只有一个请求通过
+	const workers = 8
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = verifyPurposeCode(ctx, consts.CodePurposeResetPassword, identifier, "123456")
+		}(i)
+	}
+	wg.Wait()
+
+	passed := 0
+	for _, err := range errs {
+		if err == nil {
+			passed++
+			continue
+		}
+		assertAppError(t, err, consts.ErrVerifyCodeExpired)
+	}
+	if passed != 1 {
+		t.Fatalf("应只有1个请求通过，实际%d个", passed)
+	}
+}
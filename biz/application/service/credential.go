@@ -0,0 +1,222 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/ldap"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// credentialVerifier 密码的校验方式，本地密码哈希或LDAP目录
+type credentialVerifier interface {
+	// verify 校验密码并维护登录失败计数，返回通过校验的用户
+	// foundUser为nil表示本地没有该用户；密码错误或用户不存在时返回ErrInvalidCredentials
+	verify(ctx context.Context, foundUser *user.User, id *loginIdentity, plainPassword string, clientIP string) (*user.User, error)
+}
+
+// credentialVerifierFor 选择密码的校验方式
+// 用户已标记为目录用户，或使用由目录管理的域名的邮箱登录时使用LDAP，否则使用本地密码
+func (s *AuthServiceImpl) credentialVerifierFor(foundUser *user.User, id *loginIdentity) credentialVerifier {
+	if foundUser != nil && foundUser.CredentialSource == consts.CredentialSourceLDAP {
+		return &ldapVerifier{s: s}
+	}
+	if id.Type == consts.IdentityTypeEmail && ldap.ManagesEmail(id.Identifier) {
+		return &ldapVerifier{s: s}
+	}
+	return &localVerifier{s: s}
+}
+
+// verifyCurrentPassword 已登录用户重新输入密码时校验，密码错误返回ErrPasswordIncorrect
+func (s *AuthServiceImpl) verifyCurrentPassword(ctx context.Context, foundUser *user.User, plainPassword string, clientIP string) error {
+	id := emailIdentity(foundUser.Email)
+	if foundUser.Email == "" {
+		id = &loginIdentity{Type: consts.IdentityTypePhone, Identifier: foundUser.Phone}
+	}
+
+	_, err := s.credentialVerifierFor(foundUser, id).verify(ctx, foundUser, id, plainPassword, clientIP)
+	var appErr *consts.AppError
+	if errors.As(err, &appErr) && appErr.Code == consts.ErrInvalidCredentials {
+		return consts.NewAppErrorWithCode(consts.ErrPasswordIncorrect)
+	}
+	return err
+}
+
+// reauthenticate 敏感操作前重新验证身份，填写当前密码，或在已开启两步验证时填写动态验证码
+// 与登录共用锁定规则，密码或验证码错误计入登录失败次数
+func (s *AuthServiceImpl) reauthenticate(ctx context.Context, foundUser *user.User, plainPassword string, totpCode string, clientIP string) error {
+	if plainPassword == "" && totpCode == "" {
+		return consts.NewAppError(consts.ErrParams, "请填写当前密码或动态验证码")
+	}
+
+	if err := checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP); err != nil {
+		return err
+	}
+
+	if plainPassword != "" {
+		return s.verifyCurrentPassword(ctx, foundUser, plainPassword, clientIP)
+	}
+	if !foundUser.TOTPEnabled {
+		return consts.NewAppErrorWithCode(consts.ErrMFANotEnabled)
+	}
+	return verifyTOTP(ctx, foundUser, totpCode, clientIP)
+}
+
+// checkPasswordManaged 目录用户的密码只能在目录中修改
+func checkPasswordManaged(foundUser *user.User) error {
+	if foundUser.CredentialSource == consts.CredentialSourceLDAP {
+		return consts.NewAppErrorWithCode(consts.ErrPasswordManaged)
+	}
+	return nil
+}
+
+// isDirectoryUser 用户是否由目录管理：已通过目录登录过，或主邮箱属于目录管理的域名
+func isDirectoryUser(foundUser *user.User) bool {
+	return foundUser.CredentialSource == consts.CredentialSourceLDAP || ldap.ManagesEmail(foundUser.Email)
+}
+
+// checkDirectoryLogin 目录用户只能使用目录密码登录
+// 验证码、登录链接、通行密钥和第三方登录都不经过目录，在目录中停用的账号仍能通过这些方式登录
+func checkDirectoryLogin(foundUser *user.User) error {
+	if isDirectoryUser(foundUser) {
+		return consts.NewAppErrorWithCode(consts.ErrDirectoryLogin)
+	}
+	return nil
+}
+
+// checkDirectoryIdentity 由目录管理的域名的邮箱只能通过目录登录自动创建用户，不能注册或绑定到本地账号
+func checkDirectoryIdentity(id *loginIdentity) error {
+	if id.Type == consts.IdentityTypeEmail && ldap.ManagesEmail(id.Identifier) {
+		return consts.NewAppErrorWithCode(consts.ErrDirectoryLogin)
+	}
+	return nil
+}
+
+// localVerifier 使用本地保存的密码哈希校验
+type localVerifier struct {
+	s *AuthServiceImpl
+}
+
+// verify 实现credentialVerifier接口，哈希算法或参数已更新时用本次的明文密码重新计算
+func (v *localVerifier) verify(ctx context.Context, foundUser *user.User, id *loginIdentity, plainPassword string, clientIP string) (*user.User, error) {
+	if foundUser == nil {
+		// 用户不存在，只增加IP维度的失败次数
+		util.HandleLoginFailForNonExistentUser(ctx, clientIP)
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	}
+
+	if !verifyPassword(ctx, foundUser, plainPassword, clientIP) {
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	v.s.rehashPasswordIfNeeded(mongoCtx, foundUser, plainPassword)
+	return foundUser, nil
+}
+
+// ldapVerifier 以用户在目录中的DN和密码简单绑定校验
+// 本地没有该用户时按目录即时创建，每次登录按所属组同步用户角色
+type ldapVerifier struct {
+	s *AuthServiceImpl
+}
+
+// verify 实现credentialVerifier接口
+func (v *ldapVerifier) verify(ctx context.Context, foundUser *user.User, id *loginIdentity, plainPassword string, clientIP string) (*user.User, error) {
+	// 目录按邮箱查找用户，使用手机号登录的目录用户用账号的邮箱查找
+	userEmail := id.Identifier
+	if foundUser != nil {
+		userEmail = util.NormalizeEmail(foundUser.Email)
+	}
+	if userEmail == "" {
+		util.HandleLoginFail(ctx, foundUser.LoginIdentifier(), clientIP)
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	}
+
+	account, err := ldap.Authenticate(ctx, userEmail, plainPassword)
+	switch {
+	case errors.Is(err, ldap.ErrUserNotFound) && foundUser == nil:
+		util.HandleLoginFailForNonExistentUser(ctx, clientIP)
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	case errors.Is(err, ldap.ErrUserNotFound), errors.Is(err, ldap.ErrInvalidCredentials):
+		// 目录中已删除的用户与密码错误一样处理，不能再登录
+		util.HandleLoginFail(ctx, userEmail, clientIP)
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	case err != nil:
+		// 目录不可用时不计入失败次数，也不回退到本地密码
+		fmt.Println("LDAP校验密码失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrLDAPUnavailable)
+	}
+
+	// 校验成功，重置失败计数
+	go func() {
+		util.ResetLoginFailEmailCount(context.Background(), userEmail)
+		util.ResetLoginFailIPCount(context.Background(), clientIP)
+	}()
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if foundUser == nil {
+		return v.s.provisionDirectoryUser(mongoCtx, account)
+	}
+
+	// 同步角色，原来使用本地密码的用户改为由目录管理
+	if foundUser.Role != account.Role || foundUser.CredentialSource != consts.CredentialSourceLDAP {
+		foundUser.Role = account.Role
+		foundUser.CredentialSource = consts.CredentialSourceLDAP
+		if err = v.s.userDAO.Update(mongoCtx, foundUser); err != nil {
+			fmt.Println("同步目录用户角色失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+		}
+	}
+	return foundUser, nil
+}
+
+// provisionDirectoryUser 目录用户首次登录时创建本地用户，本地不保存密码
+func (s *AuthServiceImpl) provisionDirectoryUser(ctx context.Context, account *ldap.Account) (*user.User, error) {
+	userEmail := util.NormalizeEmail(account.Email)
+	newUser := &user.User{
+		Email:            userEmail,
+		Role:             account.Role,
+		CredentialSource: consts.CredentialSourceLDAP,
+	}
+	if err := s.userDAO.Create(ctx, newUser); err != nil {
+		fmt.Println("创建目录用户失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 写入邮箱登录标识，同一用户并发首次登录时由唯一索引保证只创建一个
+	err := s.identityDAO.Create(ctx, &identity.Identity{
+		UserID:     newUser.ID,
+		Type:       consts.IdentityTypeEmail,
+		Identifier: userEmail,
+		Verified:   true,
+	})
+	if err == nil {
+		fmt.Println("已创建目录用户:", account.Email, account.DN)
+		return newUser, nil
+	}
+
+	if deleteErr := s.userDAO.Delete(ctx, newUser.ID); deleteErr != nil {
+		fmt.Println("删除重复创建的用户失败:", deleteErr)
+	}
+	if !errors.Is(err, identity.ErrDuplicate) {
+		fmt.Println("创建登录标识失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 并发登录时另一个请求已创建，使用已创建的用户
+	foundUser, err := s.findUserByIdentity(ctx, emailIdentity(userEmail))
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	if foundUser == nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	}
+	return foundUser, nil
+}
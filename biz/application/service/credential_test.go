@@ -0,0 +1,154 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/ldap/mockdirectory"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/util"
+	"context"
+	"testing"
+	"time"
+)
+
+// addDirectoryUser 在模拟目录中添加用户，返回邮箱
+func addDirectoryUser(uid string, password string, groups ...string) string {
+	email := uid + "@" + testDirectoryDomain
+	directoryServer.AddEntry(mockdirectory.Entry{
+		DN:       "uid=" + uid + "," + testDirectoryBaseDN,
+		Password: password,
+		Attributes: map[string][]string{
+			"objectClass": {"person"},
+			"mail":        {email},
+			"memberOf":    groups,
+		},
+	})
+	return email
+}
+
+// directoryLogin 使用邮箱和密码登录
+func directoryLogin(s *AuthServiceImpl, email string, password string) (*Practice.LoginResp, error) {
+	return s.Login(context.Background(), &Practice.LoginReq{Email: email, Password: password}, "127.0.0.1", "test")
+}
+
+func TestDirectoryLoginProvisionsUser(t *testing.T) {
+	s := newTestService()
+	email := addDirectoryUser("jit", "directory-password", testAdminGroup)
+
+	resp, err := directoryLogin(s, email, "directory-password")
+	if err != nil {
+		t.Fatalf("目录用户登录失败: %v", err)
+	}
+	if resp.AccessToken == "" {
+		t.Fatal("应签发令牌")
+	}
+
+	created, _ := s.findUserByIdentity(context.Background(), emailIdentity(email))
+	if created == nil {
+		t.Fatal("首次登录应即时创建本地用户")
+	}
+	if created.CredentialSource != consts.CredentialSourceLDAP || created.Password != "" || created.Role != consts.RoleAdmin {
+		t.Fatalf("创建的用户 = %+v", created)
+	}
+
+	// 再次登录使用已创建的用户
+	if _, err = directoryLogin(s, email, "directory-password"); err != nil {
+		t.Fatalf("再次登录失败: %v", err)
+	}
+	if len(s.userDAO.(*memUserDAO).users) != 1 {
+		t.Fatal("再次登录不应创建新用户")
+	}
+}
+
+func TestDirectoryLoginSyncsExistingUser(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	email := addDirectoryUser("existing", "directory-password")
+
+	// 启用目录之前注册的本地用户，本地密码不再生效
+	existing := &user.User{Email: email, Password: "local-hash", Role: consts.RoleAdmin}
+	_ = s.userDAO.Create(ctx, existing)
+	_ = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID: existing.ID, Type: consts.IdentityTypeEmail, Identifier: email, Verified: true,
+	})
+
+	if _, err := directoryLogin(s, email, "directory-password"); err != nil {
+		t.Fatalf("目录用户登录失败: %v", err)
+	}
+	synced, _ := s.userDAO.FindByID(ctx, existing.ID)
+	if synced.CredentialSource != consts.CredentialSourceLDAP || synced.Role != consts.RoleUser {
+		t.Fatalf("应按目录同步角色和密码来源: %+v", synced)
+	}
+}
+
+func TestDirectoryLoginRejectsWrongPassword(t *testing.T) {
+	s := newTestService()
+	email := addDirectoryUser("wrong", "directory-password")
+
+	_, err := directoryLogin(s, email, "wrong-password")
+	assertAppError(t, err, consts.ErrInvalidCredentials)
+
+	// 目录中没有的用户
+	_, err = directoryLogin(s, "nobody@"+testDirectoryDomain, "directory-password")
+	assertAppError(t, err, consts.ErrInvalidCredentials)
+
+	if len(s.userDAO.(*memUserDAO).users) != 0 {
+		t.Fatal("校验失败时不应创建用户")
+	}
+}
+
+func TestDirectoryUnavailable(t *testing.T) {
+	s := newTestService()
+	email := addDirectoryUser("unavailable", "directory-password")
+
+	conf := config.GetConfig()
+	previous := conf.LDAP.URL
+	conf.LDAP.URL = "ldap://127.0.0.1:1"
+	defer func() { conf.LDAP.URL = previous }()
+
+	// 目录不可用时不回退到本地密码
+	_, err := directoryLogin(s, email, "directory-password")
+	assertAppError(t, err, consts.ErrLDAPUnavailable)
+}
+
+func TestDirectoryUserPasswordlessLogin(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	email := addDirectoryUser("passwordless", "directory-password")
+	if _, err := directoryLogin(s, email, "directory-password"); err != nil {
+		t.Fatalf("目录用户登录失败: %v", err)
+	}
+	directoryUser, _ := s.findUserByIdentity(ctx, emailIdentity(email))
+
+	// 不向目录用户发送登录验证码，响应与未注册时一致
+	if _, err := s.SendLoginCode(ctx, &Practice.SendLoginCodeReq{Email: email}); err != nil {
+		t.Fatalf("发送登录验证码失败: %v", err)
+	}
+	if redisServer.Exists(util.GetPurposeCodeRedisKey(consts.CodePurposeLogin, email)) {
+		t.Fatal("不应向目录用户发送登录验证码")
+	}
+
+	// 改由目录管理之前发送的验证码不能再登录
+	_ = util.SetWithExpire(ctx, util.GetPurposeCodeRedisKey(consts.CodePurposeLogin, email), "123456", time.Minute)
+	_, err := s.LoginWithCode(ctx, &Practice.LoginWithCodeReq{Email: email, VerifyCode: "123456"}, "127.0.0.1", "test")
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+
+	// 改由目录管理之前发送的登录链接不能再登录
+	claims := jwt.MagicLinkClaims{Email: email, NonceHash: util.HashMagicLinkNonce("nonce")}
+	claims.Subject = directoryUser.ID.Hex()
+	token, tokenID, err := jwt.GenerateMagicLinkToken(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = util.SaveMagicLink(ctx, tokenID, directoryUser.ID.Hex())
+	_, err = s.ConsumeMagicLink(ctx, &Practice.ConsumeMagicLinkReq{Token: token}, "nonce", "127.0.0.1", "test")
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+
+	// 不能注册通行密钥
+	_, err = s.BeginPasskeyRegistration(ctx, &Practice.BeginPasskeyRegistrationReq{Password: "directory-password"},
+		&CurrentToken{UserID: directoryUser.ID.Hex(), SubjectType: consts.SubjectTypeUser}, "127.0.0.1")
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+}
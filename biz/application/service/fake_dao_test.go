@@ -0,0 +1,333 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/passkey"
+	"auth/biz/infrastructure/mapper/session"
+	"auth/biz/infrastructure/mapper/user"
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 测试使用的内存DAO，行为与MongoDB实现一致：按值保存，唯一索引冲突返回ErrDuplicate
+
+// memUserDAO 内存实现的用户DAO
+type memUserDAO struct {
+	mu    sync.Mutex
+	users map[primitive.ObjectID]user.User
+}
+
+var _ user.IUserDAO = (*memUserDAO)(nil)
+
+func newMemUserDAO() *memUserDAO {
+	return &memUserDAO{users: make(map[primitive.ObjectID]user.User)}
+}
+
+func (d *memUserDAO) Create(ctx context.Context, u *user.User) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if u.ID.IsZero() {
+		u.ID = primitive.NewObjectID()
+	}
+	if u.Role == "" {
+		u.Role = consts.RoleUser
+	}
+	u.CreateTime = time.Now()
+	d.users[u.ID] = *u
+	return nil
+}
+
+func (d *memUserDAO) find(match func(u *user.User) bool) *user.User {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, u := range d.users {
+		if match(&u) {
+			found := u
+			return &found
+		}
+	}
+	return nil
+}
+
+func (d *memUserDAO) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	return d.find(func(u *user.User) bool { return strings.EqualFold(u.Email, email) }), nil
+}
+
+func (d *memUserDAO) FindByPhone(ctx context.Context, phone string) (*user.User, error) {
+	return d.find(func(u *user.User) bool { return u.Phone == phone }), nil
+}
+
+func (d *memUserDAO) FindByID(ctx context.Context, id primitive.ObjectID) (*user.User, error) {
+	return d.find(func(u *user.User) bool { return u.ID == id }), nil
+}
+
+func (d *memUserDAO) FindByTimestamp(ctx context.Context, timestamp time.Time) ([]*user.User, error) {
+	return nil, nil
+}
+
+func (d *memUserDAO) FindByInt64ID(ctx context.Context, id int64) (*user.User, error) {
+	return nil, nil
+}
+
+func (d *memUserDAO) Delete(ctx context.Context, id primitive.ObjectID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.users, id)
+	return nil
+}
+
+func (d *memUserDAO) Update(ctx context.Context, u *user.User) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.users[u.ID]; ok {
+		d.users[u.ID] = *u
+	}
+	return nil
+}
+
+func (d *memUserDAO) CheckIsAdmin(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	u, _ := d.FindByID(ctx, id)
+	return u != nil && u.Role == consts.RoleAdmin, nil
+}
+
+func (d *memUserDAO) ConsumeRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	u, ok := d.users[id]
+	if !ok {
+		return false, nil
+	}
+	for i, code := range u.RecoveryCodes {
+		if code == codeHash {
+			u.RecoveryCodes = append(append([]string{}, u.RecoveryCodes[:i]...), u.RecoveryCodes[i+1:]...)
+			d.users[id] = u
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// memIdentityDAO 内存实现的登录标识DAO，(Type, Identifier)唯一
+type memIdentityDAO struct {
+	mu         sync.Mutex
+	identities []identity.Identity
+}
+
+var _ identity.IIdentityDAO = (*memIdentityDAO)(nil)
+
+func (d *memIdentityDAO) Create(ctx context.Context, item *identity.Identity) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, existing := range d.identities {
+		if existing.Type == item.Type && existing.Identifier == item.Identifier {
+			return identity.ErrDuplicate
+		}
+	}
+	if item.ID.IsZero() {
+		item.ID = primitive.NewObjectID()
+	}
+	item.CreateTime = time.Now()
+	d.identities = append(d.identities, *item)
+	return nil
+}
+
+func (d *memIdentityDAO) FindByIdentifier(ctx context.Context, identityType string, identifier string) (*identity.Identity, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, existing := range d.identities {
+		if existing.Type == identityType && existing.Identifier == identifier {
+			found := existing
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *memIdentityDAO) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]*identity.Identity, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var result []*identity.Identity
+	for _, existing := range d.identities {
+		if existing.UserID == userID {
+			found := existing
+			result = append(result, &found)
+		}
+	}
+	return result, nil
+}
+
+func (d *memIdentityDAO) Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, existing := range d.identities {
+		if existing.ID == id && existing.UserID == userID {
+			d.identities = append(d.identities[:i], d.identities[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// memPasskeyDAO 内存实现的通行密钥DAO
+type memPasskeyDAO struct {
+	mu       sync.Mutex
+	passkeys []passkey.Passkey
+}
+
+var _ passkey.IPasskeyDAO = (*memPasskeyDAO)(nil)
+
+func (d *memPasskeyDAO) Create(ctx context.Context, item *passkey.Passkey) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if item.ID.IsZero() {
+		item.ID = primitive.NewObjectID()
+	}
+	item.CreateTime = time.Now()
+	d.passkeys = append(d.passkeys, *item)
+	return nil
+}
+
+func (d *memPasskeyDAO) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]*passkey.Passkey, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var result []*passkey.Passkey
+	for _, existing := range d.passkeys {
+		if existing.UserID == userID {
+			found := existing
+			result = append(result, &found)
+		}
+	}
+	return result, nil
+}
+
+func (d *memPasskeyDAO) FindByCredentialID(ctx context.Context, credentialID []byte) (*passkey.Passkey, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, existing := range d.passkeys {
+		if bytes.Equal(existing.CredentialID, credentialID) {
+			found := existing
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *memPasskeyDAO) CountByUserID(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	found, _ := d.FindByUserID(ctx, userID)
+	return int64(len(found)), nil
+}
+
+func (d *memPasskeyDAO) UpdateUsage(ctx context.Context, item *passkey.Passkey) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, existing := range d.passkeys {
+		if existing.ID == item.ID {
+			d.passkeys[i].SignCount = item.SignCount
+			d.passkeys[i].UserVerified = item.UserVerified
+			d.passkeys[i].BackupState = item.BackupState
+			d.passkeys[i].LastUsedTime = time.Now()
+		}
+	}
+	return nil
+}
+
+func (d *memPasskeyDAO) Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, existing := range d.passkeys {
+		if existing.ID == id && existing.UserID == userID {
+			d.passkeys = append(d.passkeys[:i], d.passkeys[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// memSessionDAO 内存实现的会话DAO
+type memSessionDAO struct {
+	mu       sync.Mutex
+	sessions map[primitive.ObjectID]session.Session
+}
+
+var _ session.ISessionDAO = (*memSessionDAO)(nil)
+
+func newMemSessionDAO() *memSessionDAO {
+	return &memSessionDAO{sessions: make(map[primitive.ObjectID]session.Session)}
+}
+
+func (d *memSessionDAO) Create(ctx context.Context, item *session.Session) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if item.ID.IsZero() {
+		item.ID = primitive.NewObjectID()
+	}
+	item.CreateTime = time.Now()
+	d.sessions[item.ID] = *item
+	return nil
+}
+
+func (d *memSessionDAO) FindByID(ctx context.Context, id primitive.ObjectID) (*session.Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	item, ok := d.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	return &item, nil
+}
+
+func (d *memSessionDAO) FindActiveByUserID(ctx context.Context, userID primitive.ObjectID) ([]*session.Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var result []*session.Session
+	for _, item := range d.sessions {
+		if item.UserID == userID && !item.Revoked && item.ExpireTime.After(time.Now()) {
+			found := item
+			result = append(result, &found)
+		}
+	}
+	return result, nil
+}
+
+func (d *memSessionDAO) Touch(ctx context.Context, id primitive.ObjectID, expireTime time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if item, ok := d.sessions[id]; ok {
+		item.LastSeenTime = time.Now()
+		item.ExpireTime = expireTime
+		d.sessions[id] = item
+	}
+	return nil
+}
+
+func (d *memSessionDAO) Revoke(ctx context.Context, id primitive.ObjectID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if item, ok := d.sessions[id]; ok {
+		item.Revoked = true
+		item.RevokeTime = time.Now()
+		d.sessions[id] = item
+	}
+	return nil
+}
+
+func (d *memSessionDAO) RevokeByUserID(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var ids []primitive.ObjectID
+	for id, item := range d.sessions {
+		if item.UserID == userID && !item.Revoked {
+			item.Revoked = true
+			item.RevokeTime = time.Now()
+			d.sessions[id] = item
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,422 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/email"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/sms"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// loginIdentity 验证码、注册和登录接口提交的登录标识
+// Identifier为小写的邮箱或E.164格式的手机号，同时作为冷却、冻结和登录锁定的键
+type loginIdentity struct {
+	Type       string
+	Identifier string
+}
+
+// emailIdentity 只支持邮箱的接口使用的登录标识，邮箱统一为小写
+func emailIdentity(userEmail string) *loginIdentity {
+	return &loginIdentity{Type: consts.IdentityTypeEmail, Identifier: util.NormalizeEmail(userEmail)}
+}
+
+// resolveIdentity 从请求的email和phone字段中确定登录标识，二者必须且只能填写一个
+// 邮箱统一为小写、手机号统一转换为E.164格式，同一邮箱或号码的不同写法共享冷却、冻结和登录锁定
+func resolveIdentity(userEmail, phone string) (*loginIdentity, error) {
+	userEmail = strings.TrimSpace(userEmail)
+	phone = strings.TrimSpace(phone)
+	switch {
+	case userEmail != "" && phone != "":
+		return nil, consts.NewAppError(consts.ErrParams, "邮箱和手机号只能填写一个")
+	case userEmail != "":
+		return emailIdentity(userEmail), nil
+	case phone != "":
+		normalized, err := util.NormalizePhone(phone)
+		if err != nil {
+			return nil, consts.NewAppErrorWithCode(consts.ErrPhoneInvalid)
+		}
+		return &loginIdentity{Type: consts.IdentityTypePhone, Identifier: normalized}, nil
+	default:
+		return nil, consts.NewAppError(consts.ErrParams, "请填写邮箱或手机号")
+	}
+}
+
+// findIdentity 查找登录标识，不存在时返回nil
+// 兼容登录标识集合建立之前注册的用户：集合中没有时按users集合的邮箱或手机号查找，找到后补建登录标识
+func (s *AuthServiceImpl) findIdentity(ctx context.Context, id *loginIdentity) (*identity.Identity, error) {
+	found, err := s.identityDAO.FindByIdentifier(ctx, id.Type, id.Identifier)
+	if err != nil || found != nil {
+		return found, err
+	}
+
+	var legacyUser *user.User
+	if id.Type == consts.IdentityTypePhone {
+		legacyUser, err = s.userDAO.FindByPhone(ctx, id.Identifier)
+	} else {
+		legacyUser, err = s.userDAO.FindByEmail(ctx, id.Identifier)
+	}
+	if err != nil || legacyUser == nil {
+		return nil, err
+	}
+	return s.backfillIdentity(ctx, legacyUser.ID, id)
+}
+
+// backfillIdentity 为旧用户补建已验证的登录标识，注册时已通过验证码确认归属
+// 并发补建时唯一索引只允许一条记录，返回已存在的记录
+func (s *AuthServiceImpl) backfillIdentity(ctx context.Context, userID primitive.ObjectID, id *loginIdentity) (*identity.Identity, error) {
+	created := &identity.Identity{
+		UserID:     userID,
+		Type:       id.Type,
+		Identifier: id.Identifier,
+		Verified:   true,
+	}
+	err := s.identityDAO.Create(ctx, created)
+	if errors.Is(err, identity.ErrDuplicate) {
+		return s.identityDAO.FindByIdentifier(ctx, id.Type, id.Identifier)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// findUserByIdentity 通过已验证的登录标识查找用户，不存在或未验证时返回nil
+func (s *AuthServiceImpl) findUserByIdentity(ctx context.Context, id *loginIdentity) (*user.User, error) {
+	found, err := s.findIdentity(ctx, id)
+	if err != nil || found == nil || !found.Verified {
+		return nil, err
+	}
+	return s.userDAO.FindByID(ctx, found.UserID)
+}
+
+// checkAccountLocked 使用非主登录标识登录时，同时检查账号主登录标识的锁定状态
+// 登录失败按主登录标识计数，账号被锁定后不能换用其他邮箱或手机号继续尝试
+func checkAccountLocked(ctx context.Context, foundUser *user.User, id *loginIdentity, clientIP string) error {
+	if foundUser.LoginIdentifier() == id.Identifier {
+		return nil
+	}
+	return checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP)
+}
+
+// codeSender 按登录标识类型选择验证码的发送方式
+func codeSender(id *loginIdentity, byEmail, bySMS func(to, code string) error) func(to, code string) error {
+	if id.Type == consts.IdentityTypePhone {
+		return bySMS
+	}
+	return byEmail
+}
+
+// ListIdentities 获取当前用户绑定的邮箱和手机号
+func (s *AuthServiceImpl) ListIdentities(ctx context.Context, req *Practice.ListIdentitiesReq, current *CurrentToken) (*Practice.ListIdentitiesResp, error) {
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	identities, err := s.loadUserIdentities(mongoCtx, foundUser)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 转换为响应结构
+	infos := make([]*Practice.IdentityInfo, 0, len(identities))
+	for _, item := range identities {
+		infos = append(infos, toIdentityInfo(item, foundUser))
+	}
+
+	return &Practice.ListIdentitiesResp{
+		Code:       consts.Success,
+		Msg:        "获取登录标识列表成功",
+		Identities: infos,
+	}, nil
+}
+
+// SendIdentityCode 向要绑定的邮箱或手机号发送绑定验证码，与SendVerificationCode共用冷却和冻结限制
+// 已被其他账号使用的邮箱或手机号不能绑定，直接返回错误
+func (s *AuthServiceImpl) SendIdentityCode(ctx context.Context, req *Practice.SendIdentityCodeReq, current *CurrentToken) (*Practice.SendIdentityCodeResp, error) {
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目录管理的域名的邮箱不能绑定到本地账号
+	if err = checkDirectoryIdentity(id); err != nil {
+		return nil, err
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkIdentityAttachable(ctx, foundUser, id); err != nil {
+		return nil, err
+	}
+
+	// 检查冻结和发送冷却
+	if _, err = s.prepareCodeSend(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// 绑定验证码按identity用途单独存储，不能用于注册或登录
+	send := codeSender(id, email.SendVerificationCode, sms.SendVerificationCode)
+	if err = deliverPurposeCode(ctx, consts.CodePurposeIdentity, id, send); err != nil {
+		return nil, err
+	}
+
+	message := "验证码已发送到该邮箱，请查收"
+	if id.Type == consts.IdentityTypePhone {
+		message = "验证码已通过短信发送到该手机号，请查收"
+	}
+	return &Practice.SendIdentityCodeResp{
+		Code:    consts.Success,
+		Msg:     "验证码发送成功",
+		Message: message,
+	}, nil
+}
+
+// AttachIdentity 使用绑定验证码为当前用户绑定新的邮箱或手机号，绑定后可用于登录
+// 用户还没有邮箱或手机号时，新绑定的标识成为主邮箱或主手机号
+// 绑定后可通过该标识重置密码，需要重新输入当前密码或动态验证码，防止令牌泄露后被绑定他人的邮箱或手机号接管账号
+func (s *AuthServiceImpl) AttachIdentity(ctx context.Context, req *Practice.AttachIdentityReq, current *CurrentToken, clientIP string) (*Practice.AttachIdentityResp, error) {
+	if req.VerifyCode == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目录管理的域名的邮箱不能绑定到本地账号
+	if err = checkDirectoryIdentity(id); err != nil {
+		return nil, err
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先重新验证身份，验证失败时不消耗绑定验证码
+	if err = s.reauthenticate(ctx, foundUser, req.Password, req.TotpCode, clientIP); err != nil {
+		return nil, err
+	}
+
+	// 校验绑定验证码，注册、登录和重置密码验证码不能用于绑定
+	if err = verifyPurposeCode(ctx, consts.CodePurposeIdentity, id.Identifier, req.VerifyCode); err != nil {
+		return nil, err
+	}
+
+	// 发送验证码后可能已被其他账号注册或绑定
+	if err = s.checkIdentityAttachable(ctx, foundUser, id); err != nil {
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	created := &identity.Identity{
+		UserID:     foundUser.ID,
+		Type:       id.Type,
+		Identifier: id.Identifier,
+		Verified:   true,
+	}
+	if err = s.identityDAO.Create(mongoCtx, created); err != nil {
+		if errors.Is(err, identity.ErrDuplicate) {
+			return nil, consts.NewAppErrorWithCode(consts.ErrIdentityExists)
+		}
+		fmt.Println("创建登录标识失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 没有主邮箱或主手机号时使用新绑定的标识
+	updated := false
+	if id.Type == consts.IdentityTypeEmail && foundUser.Email == "" {
+		foundUser.Email = id.Identifier
+		updated = true
+	}
+	if id.Type == consts.IdentityTypePhone && foundUser.Phone == "" {
+		foundUser.Phone = id.Identifier
+		updated = true
+	}
+	if updated {
+		if err = s.userDAO.Update(mongoCtx, foundUser); err != nil {
+			fmt.Println("更新主登录标识失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+		}
+	}
+
+	return &Practice.AttachIdentityResp{
+		Code:     consts.Success,
+		Msg:      "绑定成功",
+		Identity: toIdentityInfo(created, foundUser),
+	}, nil
+}
+
+// DetachIdentity 解绑当前用户的指定邮箱或手机号，至少保留一个
+// 解绑的是主邮箱或主手机号时，由同类型的其他已验证标识接替
+func (s *AuthServiceImpl) DetachIdentity(ctx context.Context, req *Practice.DetachIdentityReq, current *CurrentToken) (*Practice.DetachIdentityResp, error) {
+	identityID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	identities, err := s.loadUserIdentities(mongoCtx, foundUser)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 只能解绑自己的登录标识，不存在或属于其他用户时统一返回不存在
+	index := slices.IndexFunc(identities, func(item *identity.Identity) bool {
+		return item.ID == identityID
+	})
+	if index < 0 {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	if len(identities) <= 1 {
+		return nil, consts.NewAppErrorWithCode(consts.ErrIdentityLast)
+	}
+
+	target := identities[index]
+	deleted, err := s.identityDAO.Delete(mongoCtx, foundUser.ID, identityID)
+	if err != nil {
+		fmt.Println("删除登录标识失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if !deleted {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	// 解绑主邮箱或主手机号时更新用户记录，否则旧数据兼容逻辑会按用户记录重新补建
+	remaining := slices.Delete(identities, index, index+1)
+	updated := false
+	if target.Type == consts.IdentityTypeEmail && target.Identifier == util.NormalizeEmail(foundUser.Email) {
+		foundUser.Email = nextPrimaryIdentifier(remaining, consts.IdentityTypeEmail)
+		updated = true
+	}
+	if target.Type == consts.IdentityTypePhone && target.Identifier == foundUser.Phone {
+		foundUser.Phone = nextPrimaryIdentifier(remaining, consts.IdentityTypePhone)
+		updated = true
+	}
+	if updated {
+		if err = s.userDAO.Update(mongoCtx, foundUser); err != nil {
+			fmt.Println("更新主登录标识失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+		}
+	}
+
+	return &Practice.DetachIdentityResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "已解绑",
+	}, nil
+}
+
+// checkIdentityAttachable 检查登录标识是否可以绑定到当前用户：未被任何账号使用，且未超过数量上限
+func (s *AuthServiceImpl) checkIdentityAttachable(ctx context.Context, foundUser *user.User, id *loginIdentity) error {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	existing, err := s.findIdentity(mongoCtx, id)
+	if err != nil {
+		return consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if existing != nil {
+		if existing.UserID == foundUser.ID {
+			return consts.NewAppError(consts.ErrIdentityExists, "该邮箱或手机号已绑定到当前账号")
+		}
+		return consts.NewAppErrorWithCode(consts.ErrIdentityExists)
+	}
+
+	identities, err := s.loadUserIdentities(mongoCtx, foundUser)
+	if err != nil {
+		return consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if len(identities) >= consts.IdentityMaxPerUser {
+		return consts.NewAppErrorWithCode(consts.ErrIdentityLimit)
+	}
+	return nil
+}
+
+// loadUserIdentities 获取用户的全部登录标识，用户记录中的邮箱或手机号尚未写入集合时先补建
+func (s *AuthServiceImpl) loadUserIdentities(ctx context.Context, foundUser *user.User) ([]*identity.Identity, error) {
+	identities, err := s.identityDAO.FindByUserID(ctx, foundUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var legacy []*loginIdentity
+	if foundUser.Email != "" {
+		legacy = append(legacy, emailIdentity(foundUser.Email))
+	}
+	if foundUser.Phone != "" {
+		legacy = append(legacy, &loginIdentity{Type: consts.IdentityTypePhone, Identifier: foundUser.Phone})
+	}
+
+	for _, id := range legacy {
+		exists := slices.ContainsFunc(identities, func(item *identity.Identity) bool {
+			return item.Type == id.Type && item.Identifier == id.Identifier
+		})
+		if exists {
+			continue
+		}
+
+		created, err := s.backfillIdentity(ctx, foundUser.ID, id)
+		if err != nil {
+			return nil, err
+		}
+		if created != nil && created.UserID == foundUser.ID {
+			identities = append(identities, created)
+		}
+	}
+	return identities, nil
+}
+
+// nextPrimaryIdentifier 从剩余的登录标识中选出同类型的第一个已验证标识，没有时返回空
+func nextPrimaryIdentifier(identities []*identity.Identity, identityType string) string {
+	for _, item := range identities {
+		if item.Type == identityType && item.Verified {
+			return item.Identifier
+		}
+	}
+	return ""
+}
+
+// toIdentityInfo 将登录标识实体转换为响应结构
+func toIdentityInfo(item *identity.Identity, foundUser *user.User) *Practice.IdentityInfo {
+	primary := (item.Type == consts.IdentityTypeEmail && item.Identifier == util.NormalizeEmail(foundUser.Email)) ||
+		(item.Type == consts.IdentityTypePhone && item.Identifier == foundUser.Phone)
+	return &Practice.IdentityInfo{
+		Id:         item.ID.Hex(),
+		Type:       item.Type,
+		Identifier: item.Identifier,
+		Verified:   item.Verified,
+		Primary:    primary,
+		CreateTime: item.CreateTime.Unix(),
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/totp"
+	"auth/biz/infrastructure/util"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestResolveIdentityNormalizesEmail(t *testing.T) {
+	id, err := resolveIdentity("  Alice@Example.COM ", "")
+	if err != nil {
+		t.Fatalf("resolveIdentity: %v", err)
+	}
+	if id.Type != consts.IdentityTypeEmail || id.Identifier != "alice@example.com" {
+		t.Fatalf("id = %+v", id)
+	}
+
+	// 只有空白的邮箱视为未填写
+	_, err = resolveIdentity("   ", "")
+	assertAppError(t, err, consts.ErrParams)
+}
+
+func TestFindLegacyUserIgnoresEmailCase(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+
+	// 登录标识集合建立之前注册、邮箱含大写字母的用户
+	legacy := &user.User{Email: "Legacy@Example.com", Password: "hash"}
+	_ = s.userDAO.Create(ctx, legacy)
+
+	found, err := s.findUserByIdentity(ctx, emailIdentity(" LEGACY@example.com"))
+	if err != nil || found == nil || found.ID != legacy.ID {
+		t.Fatalf("应按不区分大小写的邮箱找到旧用户: %v %v", found, err)
+	}
+	if found.LoginIdentifier() != "legacy@example.com" {
+		t.Fatalf("LoginIdentifier = %q", found.LoginIdentifier())
+	}
+
+	// 补建的登录标识为小写，之后的查找不再经过users集合
+	backfilled, _ := s.identityDAO.FindByIdentifier(ctx, consts.IdentityTypeEmail, "legacy@example.com")
+	if backfilled == nil || backfilled.UserID != legacy.ID {
+		t.Fatal("应以小写邮箱补建登录标识")
+	}
+	identities, _ := s.loadUserIdentities(ctx, found)
+	if len(identities) != 1 || !toIdentityInfo(identities[0], found).Primary {
+		t.Fatalf("不应为同一邮箱的其他写法重复补建: %+v", identities)
+	}
+}
+
+func TestAttachIdentityRequiresReauthentication(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	passwordHash, _ := hashPassword("current-password")
+	existing := &user.User{Email: "owner@example.com", Password: passwordHash}
+	_ = s.userDAO.Create(ctx, existing)
+	_ = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID: existing.ID, Type: consts.IdentityTypeEmail, Identifier: existing.Email, Verified: true,
+	})
+	current := &CurrentToken{UserID: existing.ID.Hex(), SubjectType: consts.SubjectTypeUser}
+	codeKey := util.GetPurposeCodeRedisKey(consts.CodePurposeIdentity, "another@example.com")
+	_ = util.SetWithExpire(ctx, codeKey, "123456", time.Minute)
+
+	attach := func(password string, totpCode string) error {
+		req := &Practice.AttachIdentityReq{Email: "another@example.com", VerifyCode: "123456", Password: password, TotpCode: totpCode}
+		_, err := s.AttachIdentity(ctx, req, current, "127.0.0.1")
+		return err
+	}
+
+	// 只有令牌不能绑定，重新验证身份失败时不消耗绑定验证码
+	assertAppError(t, attach("", ""), consts.ErrParams)
+	assertAppError(t, attach("wrong-password", ""), consts.ErrPasswordIncorrect)
+	assertAppError(t, attach("", "000000"), consts.ErrMFANotEnabled)
+	if !redisServer.Exists(codeKey) {
+		t.Fatal("重新验证身份失败时不应消耗绑定验证码")
+	}
+
+	if err := attach("current-password", ""); err != nil {
+		t.Fatalf("绑定失败: %v", err)
+	}
+	attached, _ := s.identityDAO.FindByIdentifier(ctx, consts.IdentityTypeEmail, "another@example.com")
+	if attached == nil || attached.UserID != existing.ID {
+		t.Fatal("应绑定新邮箱")
+	}
+}
+
+func TestAttachIdentityWithTOTP(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+
+	// 通过第三方登录创建、没有密码但已开启两步验证的用户
+	secret, _ := totp.GenerateSecret()
+	encrypted, err := util.EncryptSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing := &user.User{Email: "social@example.com", TOTPEnabled: true, TOTPSecret: encrypted}
+	_ = s.userDAO.Create(ctx, existing)
+	current := &CurrentToken{UserID: existing.ID.Hex(), SubjectType: consts.SubjectTypeUser}
+	_ = util.SetWithExpire(ctx, util.GetPurposeCodeRedisKey(consts.CodePurposeIdentity, "+8613800138000"), "123456", time.Minute)
+
+	code, _ := totp.Code(secret, totp.Step(time.Now()))
+	req := &Practice.AttachIdentityReq{Phone: "+8613800138000", VerifyCode: "123456", TotpCode: code}
+	if _, err = s.AttachIdentity(ctx, req, current, "127.0.0.1"); err != nil {
+		t.Fatalf("使用动态验证码绑定失败: %v", err)
+	}
+}
+
+func TestDirectoryEmailCannotRegisterOrAttach(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	managed := " Staff@CORP.Example.com "
+
+	// 注册不能为目录用户设置本地密码
+	_, err := s.Register(ctx, &Practice.RegisterReq{
+		Email:      managed,
+		Password:   "Local-Passw0rd-2024",
+		VerifyCode: "123456",
+	}, "127.0.0.1", "test")
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+
+	// 也不能作为其他账号的登录标识
+	current := newPasskeyUser(t, s, &user.User{Email: "owner@example.com"})
+	_, err = s.SendIdentityCode(ctx, &Practice.SendIdentityCodeReq{Email: managed}, current)
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+
+	_ = util.SetWithExpire(ctx, util.GetPurposeCodeRedisKey(consts.CodePurposeIdentity, "staff@corp.example.com"), "123456", time.Minute)
+	_, err = s.AttachIdentity(ctx, &Practice.AttachIdentityReq{
+		Email:      managed,
+		VerifyCode: "123456",
+		Password:   "current-password",
+	}, current, "127.0.0.1")
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+}
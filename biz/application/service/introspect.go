@@ -0,0 +1,127 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// 令牌类型提示（RFC 7662 token_type_hint）
+const (
+	TokenTypeAccessToken  = "access_token"
+	TokenTypeRefreshToken = "refresh_token"
+)
+
+// IntrospectReq 令牌自省请求（RFC 7662），以表单提交
+type IntrospectReq struct {
+	Token         string `form:"token" json:"token" query:"token"`
+	TokenTypeHint string `form:"token_type_hint" json:"token_type_hint" query:"token_type_hint"`
+}
+
+// IntrospectResp 令牌自省响应（RFC 7662），token无效时只返回active=false
+type IntrospectResp struct {
+	Active    bool   `json:"active"`
+	Sub       string `json:"sub,omitempty"`
+	SubType   string `json:"sub_type,omitempty"` // 主体类型：user-用户，service-服务账号
+	Email     string `json:"email,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+}
+
+// Introspect 令牌自省，供资源服务器和网关集中校验token
+// 与JWTAuth使用相同的解析和吊销检查逻辑；无法识别为access token时再按refresh token查找
+// Redis不可用时返回ErrRedis，不能返回active=false，否则调用方会把存储故障当作token已失效
+func (s *AuthServiceImpl) Introspect(ctx context.Context, req *IntrospectReq) (*IntrospectResp, error) {
+	inactive := &IntrospectResp{Active: false}
+	if req.Token == "" {
+		return inactive, nil
+	}
+
+	// 按提示决定查找顺序，提示只是优化，找不到时仍尝试另一种类型
+	lookups := []func(context.Context, string) (*IntrospectResp, error){introspectAccessToken, introspectRefreshToken}
+	if req.TokenTypeHint == TokenTypeRefreshToken {
+		lookups[0], lookups[1] = lookups[1], lookups[0]
+	}
+
+	for _, lookup := range lookups {
+		resp, err := lookup(ctx, req.Token)
+		if err != nil {
+			return nil, err
+		}
+		if resp != nil {
+			return resp, nil
+		}
+	}
+	return inactive, nil
+}
+
+// introspectAccessToken 按access token自省，无效时返回nil
+func introspectAccessToken(ctx context.Context, token string) (*IntrospectResp, error) {
+	claims, err := jwt.ParseToken(token)
+	if errors.Is(err, jwt.ErrRevocationCheck) {
+		fmt.Println("检查access token吊销状态失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	if err != nil {
+		return nil, nil
+	}
+
+	return &IntrospectResp{
+		Active:    true,
+		Sub:       claims.UserId,
+		SubType:   claims.SubjectType(),
+		Email:     claims.Email,
+		Exp:       claims.ExpiresAt,
+		Iat:       claims.IssuedAt,
+		Scope:     claims.Scope,
+		ClientID:  claims.ClientId,
+		Jti:       claims.Id,
+		TokenType: TokenTypeAccessToken,
+	}, nil
+}
+
+// introspectRefreshToken 按refresh token自省，不消费token，无效时返回nil
+func introspectRefreshToken(ctx context.Context, token string) (*IntrospectResp, error) {
+	data, err := util.GetRefreshToken(ctx, token)
+	if err != nil {
+		fmt.Println("读取refresh token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	// 检查token族和用户是否已被吊销
+	revoked, err := util.IsRefreshFamilyRevoked(ctx, data.FamilyID)
+	if err != nil {
+		fmt.Println("检查refresh token族吊销状态失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	revokeTime, err := util.GetUserTokensRevokeTime(ctx, data.UserID, data.FamilyID)
+	if err != nil {
+		fmt.Println("获取用户token吊销时间失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	if revoked || util.IssuedBeforeRevoke(util.TokenIssuedAtMilli(data.IssuedAt, data.IssuedAtMilli), revokeTime) {
+		return nil, nil
+	}
+
+	return &IntrospectResp{
+		Active:    true,
+		Sub:       data.UserID,
+		SubType:   consts.SubjectTypeUser,
+		Email:     data.Email,
+		Exp:       data.ExpireAt,
+		Iat:       data.IssuedAt,
+		Scope:     data.Scope,
+		ClientID:  data.ClientID,
+		TokenType: TokenTypeRefreshToken,
+	}, nil
+}
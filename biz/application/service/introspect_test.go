@@ -0,0 +1,61 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"context"
+	"testing"
+)
+
+func TestIntrospect(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    "507f1f77bcf86cd799439011",
+		Email:     "introspect@example.com",
+		SessionID: "507f1f77bcf86cd799439012",
+		Scope:     consts.DefaultLoginScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for token, tokenType := range map[string]string{
+		tokens.AccessToken:  TokenTypeAccessToken,
+		tokens.RefreshToken: TokenTypeRefreshToken,
+	} {
+		resp, err := s.Introspect(ctx, &IntrospectReq{Token: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !resp.Active || resp.TokenType != tokenType || resp.Email != "introspect@example.com" {
+			t.Fatalf("%s: resp = %+v", tokenType, resp)
+		}
+	}
+
+	resp, err := s.Introspect(ctx, &IntrospectReq{Token: "not-a-token"})
+	if err != nil || resp.Active {
+		t.Fatalf("无效token: resp = %+v, err = %v", resp, err)
+	}
+}
+
+func TestIntrospectRedisUnavailable(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    "507f1f77bcf86cd799439011",
+		Email:     "introspect@example.com",
+		SessionID: "507f1f77bcf86cd799439012",
+		Scope:     consts.DefaultLoginScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 无法检查吊销状态时返回错误，不能返回active=false
+	redisServer.SetError("server unavailable")
+	defer redisServer.SetError("")
+	for _, token := range []string{tokens.AccessToken, tokens.RefreshToken} {
+		_, err = s.Introspect(ctx, &IntrospectReq{Token: token})
+		assertAppError(t, err, consts.ErrRedis)
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/email"
+	"auth/biz/infrastructure/sms"
+	"auth/biz/infrastructure/util"
+	"context"
+)
+
+// SendLoginCode 向已注册的邮箱或手机号发送登录验证码
+// 与SendVerificationCode共用冷却和冻结限制；未注册时同样返回成功，避免暴露账号是否存在
+func (s *AuthServiceImpl) SendLoginCode(ctx context.Context, req *Practice.SendLoginCodeReq) (*Practice.SendLoginCodeResp, error) {
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	foundUser, err := s.prepareCodeSend(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已注册时才发送登录验证码；目录用户只能使用目录密码登录，与未注册一样不发送
+	if foundUser != nil && !isDirectoryUser(foundUser) {
+		send := codeSender(id, email.SendLoginCode, sms.SendLoginCode)
+		if err = deliverPurposeCode(ctx, consts.CodePurposeLogin, id, send); err != nil {
+			return nil, err
+		}
+	}
+
+	if id.Type == consts.IdentityTypePhone {
+		return &Practice.SendLoginCodeResp{
+			Code:    consts.Success,
+			Msg:     "操作成功",
+			Message: "如果该手机号已注册，登录验证码已通过短信发送到您的手机，请查收",
+		}, nil
+	}
+
+	return &Practice.SendLoginCodeResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "如果该邮箱已注册，登录验证码已发送到您的邮箱，请查收",
+	}, nil
+}
+
+// LoginWithCode 使用邮箱或手机号登录验证码登录
+// 验证码错误按验证码规则计数和冻结；邮箱、手机号或IP被登录锁定时同样不允许登录
+// 目录用户不能使用验证码登录，在目录中停用账号后验证码不能绕过目录
+func (s *AuthServiceImpl) LoginWithCode(ctx context.Context, req *Practice.LoginWithCodeReq, clientIP string, userAgent string) (*Practice.LoginWithCodeResp, error) {
+	if req.VerifyCode == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	id, err := resolveIdentity(req.Email, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验申请的授权范围
+	scope, err := resolveLoginScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查邮箱或手机号和IP是否被锁定
+	if err = checkLoginLocked(ctx, id.Identifier, clientIP); err != nil {
+		return nil, err
+	}
+
+	// 校验登录验证码，注册验证码和重置密码验证码不能用于登录
+	if err = verifyPurposeCode(ctx, consts.CodePurposeLogin, id.Identifier, req.VerifyCode); err != nil {
+		return nil, err
+	}
+
+	// 查找用户
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.findUserByIdentity(mongoCtx, id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 验证码只发送给已注册的邮箱或手机号，用户不存在说明发送后已被删除
+	if foundUser == nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrInvalidCredentials)
+	}
+
+	// 发送验证码后才改由目录管理的用户
+	if err = checkDirectoryLogin(foundUser); err != nil {
+		return nil, err
+	}
+
+	// 使用非主邮箱或手机号登录时，检查账号是否被锁定
+	if err = checkAccountLocked(ctx, foundUser, id, clientIP); err != nil {
+		return nil, err
+	}
+
+	// 已开启两步验证时只返回mfa token
+	if foundUser.TOTPEnabled {
+		challenge, err := s.startMFAChallenge(ctx, foundUser, scope)
+		if err != nil {
+			return nil, err
+		}
+		return &Practice.LoginWithCodeResp{
+			Scope:                 scope,
+			PasswordResetRequired: foundUser.PasswordResetRequired,
+			MfaRequired:           true,
+			MfaToken:              challenge.Token,
+			MfaExpire:             challenge.Expire,
+		}, nil
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, foundUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    foundUser.ID.Hex(),
+		Email:     foundUser.Email,
+		SessionID: sessionID,
+		Scope:     scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.LoginWithCodeResp{
+		AccessToken:           tokens.AccessToken,
+		AccessExpire:          tokens.AccessExpire,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshExpire:         tokens.RefreshExpire,
+		Scope:                 scope,
+		PasswordResetRequired: foundUser.PasswordResetRequired,
+	}, nil
+}
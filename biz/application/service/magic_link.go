@@ -0,0 +1,177 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/email"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/util"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/url"
+)
+
+// SendMagicLink 向已注册的邮箱发送登录链接，链接绑定发起请求的浏览器nonce
+// 与SendVerificationCode共用冷却和冻结限制；邮箱未注册时同样返回成功，避免暴露账号是否存在
+func (s *AuthServiceImpl) SendMagicLink(ctx context.Context, req *Practice.SendMagicLinkReq, browserNonce string) (*Practice.SendMagicLinkResp, error) {
+	if req.Email == "" || browserNonce == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	// 校验申请的授权范围，写入链接token，使用链接时按此授予
+	scope, err := resolveLoginScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	id := emailIdentity(req.Email)
+	foundUser, err := s.prepareCodeSend(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目录用户只能使用目录密码登录，与未注册一样不发送
+	if foundUser != nil && !isDirectoryUser(foundUser) {
+		// 签发登录链接token
+		claims := jwt.MagicLinkClaims{
+			Email:     id.Identifier,
+			NonceHash: util.HashMagicLinkNonce(browserNonce),
+			Scope:     scope,
+		}
+		claims.Subject = foundUser.ID.Hex()
+
+		token, tokenID, err := jwt.GenerateMagicLinkToken(claims)
+		if err != nil {
+			fmt.Println("签发登录链接失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+		}
+
+		// 记录未使用的链接，使用时原子删除
+		if err = util.SaveMagicLink(ctx, tokenID, foundUser.ID.Hex()); err != nil {
+			fmt.Println("Redis存储登录链接失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		}
+
+		// 发送登录链接邮件
+		link := config.GetConfig().MagicLink.ConsumeURL + "?token=" + url.QueryEscape(token)
+		if err = email.SendMagicLink(id.Identifier, link); err != nil {
+			fmt.Println("发送登录链接邮件失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+		}
+	}
+
+	return &Practice.SendMagicLinkResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "如果该邮箱已注册，登录链接已发送到您的邮箱，请在当前浏览器中打开",
+	}, nil
+}
+
+// ConsumeMagicLink 使用登录链接登录
+// 先校验签名和浏览器nonce，通过后才在Redis中原子删除链接记录，转发到其他浏览器的链接不会被消耗
+func (s *AuthServiceImpl) ConsumeMagicLink(ctx context.Context, req *Practice.ConsumeMagicLinkReq, browserNonce string, clientIP string, userAgent string) (*Practice.ConsumeMagicLinkResp, error) {
+	if req.Token == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	// 校验签名、有效期和受众
+	claims, err := jwt.ParseMagicLinkToken(req.Token)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMagicLinkInvalid)
+	}
+
+	// 校验链接是否在发起登录的浏览器中打开
+	nonceHash := util.HashMagicLinkNonce(browserNonce)
+	if browserNonce == "" || subtle.ConstantTimeCompare([]byte(nonceHash), []byte(claims.NonceHash)) != 1 {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMagicLinkBrowser)
+	}
+
+	// 检查邮箱和IP是否被锁定
+	if err = checkLoginLocked(ctx, claims.Email, clientIP); err != nil {
+		return nil, err
+	}
+
+	// 原子删除链接记录，并发请求中只有一个能成功
+	userID, err := util.ConsumeMagicLink(ctx, claims.Id)
+	if err != nil {
+		fmt.Println("使用登录链接失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if userID == "" || userID != claims.Subject {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMagicLinkInvalid)
+	}
+
+	// 查找用户，发送链接后邮箱已解绑或绑定到其他账号的链接视为无效
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	id := emailIdentity(claims.Email)
+	foundUser, err := s.findUserByIdentity(mongoCtx, id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if foundUser == nil || foundUser.ID.Hex() != userID {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMagicLinkInvalid)
+	}
+
+	// 发送链接后才改由目录管理的用户
+	if err = checkDirectoryLogin(foundUser); err != nil {
+		return nil, err
+	}
+
+	// 使用非主邮箱登录时，检查账号是否被锁定
+	if err = checkAccountLocked(ctx, foundUser, id, clientIP); err != nil {
+		return nil, err
+	}
+
+	scope := claims.Scope
+	if scope == "" {
+		scope = consts.DefaultLoginScope
+	}
+
+	// 已开启两步验证时只返回mfa token
+	if foundUser.TOTPEnabled {
+		challenge, err := s.startMFAChallenge(ctx, foundUser, scope)
+		if err != nil {
+			return nil, err
+		}
+		return &Practice.ConsumeMagicLinkResp{
+			Scope:                 scope,
+			PasswordResetRequired: foundUser.PasswordResetRequired,
+			MfaRequired:           true,
+			MfaToken:              challenge.Token,
+			MfaExpire:             challenge.Expire,
+		}, nil
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, foundUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    foundUser.ID.Hex(),
+		Email:     foundUser.Email,
+		SessionID: sessionID,
+		Scope:     scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.ConsumeMagicLinkResp{
+		AccessToken:           tokens.AccessToken,
+		AccessExpire:          tokens.AccessExpire,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshExpire:         tokens.RefreshExpire,
+		Scope:                 scope,
+		PasswordResetRequired: foundUser.PasswordResetRequired,
+	}, nil
+}
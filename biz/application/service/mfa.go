@@ -0,0 +1,443 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/totp"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"time"
+)
+
+// mfaChallenge 密码等第一步验证通过后签发的mfa token
+type mfaChallenge struct {
+	Token  string
+	Expire int64
+}
+
+// EnrollTOTP 开始绑定TOTP，生成新密钥，确认前不生效
+// 重复调用会生成新的密钥并覆盖尚未确认的密钥
+func (s *AuthServiceImpl) EnrollTOTP(ctx context.Context, req *Practice.EnrollTOTPReq, current *CurrentToken) (*Practice.EnrollTOTPResp, error) {
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if foundUser.TOTPEnabled {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFAAlreadyEnabled)
+	}
+
+	// 没有加密密钥时不能保存TOTP密钥，不使用任何内置密钥代替
+	if !util.SecretKeyConfigured() {
+		fmt.Println("未配置MFA.EncryptionKey，拒绝开启两步验证")
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFAUnavailable)
+	}
+
+	// 生成密钥，加密后暂存，确认后才写入用户
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	encrypted, err := util.EncryptSecret(secret)
+	if err != nil {
+		fmt.Println("加密TOTP密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	if err = util.SaveTOTPEnrollment(ctx, current.UserID, encrypted); err != nil {
+		fmt.Println("存储待确认TOTP密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return &Practice.EnrollTOTPResp{
+		Code:       consts.Success,
+		Msg:        "操作成功",
+		Secret:     secret,
+		OtpauthUri: totp.URI(config.GetConfig().MFA.Issuer, foundUser.LoginIdentifier(), secret),
+	}, nil
+}
+
+// ConfirmTOTP 使用验证器App生成的第一个验证码确认绑定，确认后登录需要两步验证
+func (s *AuthServiceImpl) ConfirmTOTP(ctx context.Context, req *Practice.ConfirmTOTPReq, current *CurrentToken) (*Practice.ConfirmTOTPResp, error) {
+	if req.TotpCode == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if foundUser.TOTPEnabled {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFAAlreadyEnabled)
+	}
+
+	// 获取待确认的密钥
+	encrypted, err := util.GetTOTPEnrollment(ctx, current.UserID)
+	if err != nil {
+		fmt.Println("获取待确认TOTP密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if encrypted == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFAEnrollExpired)
+	}
+
+	secret, err := util.DecryptSecret(encrypted)
+	if err != nil {
+		fmt.Println("解密TOTP密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	// 校验验证码，并记录该时间步已使用
+	step, ok := totp.Validate(secret, req.TotpCode, time.Now())
+	if !ok {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFACodeInvalid)
+	}
+
+	if _, err = util.MarkTOTPStepUsed(ctx, current.UserID, step); err != nil {
+		fmt.Println("记录TOTP时间步失败:", err)
+		// 非致命错误，继续流程
+	}
+
+	// 生成恢复码，丢失验证器时用于完成两步验证
+	recoveryCodes, recoveryHashes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		fmt.Println("生成恢复码失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	// 写入用户
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser.TOTPEnabled = true
+	foundUser.TOTPSecret = encrypted
+	foundUser.RecoveryCodes = recoveryHashes
+	if err = s.userDAO.Update(mongoCtx, foundUser); err != nil {
+		fmt.Println("保存TOTP密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	util.DeleteTOTPEnrollment(ctx, current.UserID)
+
+	return &Practice.ConfirmTOTPResp{
+		Code:          consts.Success,
+		Msg:           "操作成功",
+		Message:       "两步验证已开启，下次登录时需要输入动态验证码，请妥善保存恢复码",
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTOTP 关闭TOTP两步验证，需要校验当前的动态验证码
+// 验证码错误计入登录失败次数
+func (s *AuthServiceImpl) DisableTOTP(ctx context.Context, req *Practice.DisableTOTPReq, current *CurrentToken, clientIP string) (*Practice.DisableTOTPResp, error) {
+	if req.TotpCode == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if !foundUser.TOTPEnabled {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFANotEnabled)
+	}
+
+	if err = checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP); err != nil {
+		return nil, err
+	}
+
+	if err = verifyTOTP(ctx, foundUser, req.TotpCode, clientIP); err != nil {
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser.TOTPEnabled = false
+	foundUser.TOTPSecret = ""
+	foundUser.RecoveryCodes = nil
+	if err = s.userDAO.Update(mongoCtx, foundUser); err != nil {
+		fmt.Println("关闭两步验证失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	return &Practice.DisableTOTPResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "两步验证已关闭",
+	}, nil
+}
+
+// LoginWithMFA 使用mfa token和动态验证码或恢复码完成两步验证登录
+// 验证码或恢复码错误计入登录失败次数；mfa token只能成功使用一次
+func (s *AuthServiceImpl) LoginWithMFA(ctx context.Context, req *Practice.LoginWithMFAReq, clientIP string, userAgent string) (*Practice.LoginWithMFAResp, error) {
+	if req.MfaToken == "" || (req.TotpCode == "" && req.RecoveryCode == "") {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	// 校验mfa token
+	claims, err := jwt.ParseMFAPendingToken(req.MfaToken)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFATokenInvalid)
+	}
+
+	// 检查邮箱或手机号和IP是否被锁定
+	if err = checkLoginLocked(ctx, claims.LoginIdentifier(), clientIP); err != nil {
+		return nil, err
+	}
+
+	// mfa token已使用或已过期
+	userID, err := util.GetMFAPending(ctx, claims.Id)
+	if err != nil {
+		fmt.Println("获取待完成两步验证的登录失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if userID == "" || userID != claims.Subject {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFATokenInvalid)
+	}
+
+	// 查找用户
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.findUserByHex(mongoCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !foundUser.TOTPEnabled {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFATokenInvalid)
+	}
+
+	// 校验动态验证码，未填写时校验恢复码，此时还不消耗恢复码
+	if req.TotpCode != "" {
+		err = verifyTOTP(ctx, foundUser, req.TotpCode, clientIP)
+	} else {
+		err = checkRecoveryCode(ctx, foundUser, req.RecoveryCode, clientIP)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 原子删除待完成记录，并发请求中只有一个能换取令牌
+	userID, err = util.ConsumeMFAPending(ctx, claims.Id)
+	if err != nil {
+		fmt.Println("使用mfa token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if userID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFATokenInvalid)
+	}
+
+	// 只有换取令牌的请求才消耗恢复码，并发请求不会用掉多个恢复码
+	var recoveryCodesRemaining int64
+	if req.TotpCode == "" {
+		recoveryCodesRemaining, err = s.useRecoveryCode(mongoCtx, foundUser, req.RecoveryCode, clientIP)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, foundUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    foundUser.ID.Hex(),
+		Email:     foundUser.Email,
+		SessionID: sessionID,
+		Scope:     claims.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.LoginWithMFAResp{
+		AccessToken:            tokens.AccessToken,
+		AccessExpire:           tokens.AccessExpire,
+		RefreshToken:           tokens.RefreshToken,
+		RefreshExpire:          tokens.RefreshExpire,
+		Scope:                  claims.Scope,
+		PasswordResetRequired:  foundUser.PasswordResetRequired,
+		RecoveryCodesRemaining: recoveryCodesRemaining,
+	}, nil
+}
+
+// startMFAChallenge 第一步验证通过后签发mfa token，代替access token返回
+func (s *AuthServiceImpl) startMFAChallenge(ctx context.Context, foundUser *user.User, scope string) (*mfaChallenge, error) {
+	claims := jwt.MFAPendingClaims{
+		Email: foundUser.Email,
+		Phone: foundUser.Phone,
+		Scope: scope,
+	}
+	claims.Subject = foundUser.ID.Hex()
+
+	token, tokenID, expire, err := jwt.GenerateMFAPendingToken(claims)
+	if err != nil {
+		fmt.Println("签发mfa token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+	}
+
+	if err = util.SaveMFAPending(ctx, tokenID, foundUser.ID.Hex()); err != nil {
+		fmt.Println("存储待完成两步验证的登录失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	return &mfaChallenge{Token: token, Expire: expire}, nil
+}
+
+// verifyTOTP 校验用户的动态验证码，同一时间步的验证码只能使用一次
+// 验证码错误或重放时计入登录失败次数，与密码错误共用锁定规则
+func verifyTOTP(ctx context.Context, foundUser *user.User, code string, clientIP string) error {
+	secret, err := util.DecryptSecret(foundUser.TOTPSecret)
+	if err != nil {
+		fmt.Println("解密TOTP密钥失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		util.HandleLoginFail(ctx, foundUser.LoginIdentifier(), clientIP)
+		return consts.NewAppErrorWithCode(consts.ErrMFACodeInvalid)
+	}
+
+	// 拒绝重放同一时间步的验证码
+	firstUse, err := util.MarkTOTPStepUsed(ctx, foundUser.ID.Hex(), step)
+	if err != nil {
+		fmt.Println("记录TOTP时间步失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if !firstUse {
+		util.HandleLoginFail(ctx, foundUser.LoginIdentifier(), clientIP)
+		return consts.NewAppErrorWithCode(consts.ErrMFACodeInvalid)
+	}
+
+	// 校验成功，重置失败计数
+	go func() {
+		util.ResetLoginFailEmailCount(context.Background(), foundUser.LoginIdentifier())
+		util.ResetLoginFailIPCount(context.Background(), clientIP)
+	}()
+	return nil
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，之前的恢复码全部失效
+// 需要重新输入当前密码，密码错误计入登录失败次数
+func (s *AuthServiceImpl) RegenerateRecoveryCodes(ctx context.Context, req *Practice.RegenerateRecoveryCodesReq, current *CurrentToken, clientIP string) (*Practice.RegenerateRecoveryCodesResp, error) {
+	if req.Password == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if !foundUser.TOTPEnabled {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMFANotEnabled)
+	}
+
+	// 与登录共用锁定规则，防止通过该接口暴力破解密码
+	if err = checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP); err != nil {
+		return nil, err
+	}
+
+	// 目录用户的密码通过LDAP校验
+	if err = s.verifyCurrentPassword(ctx, foundUser, req.Password, clientIP); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, recoveryHashes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		fmt.Println("生成恢复码失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	// 整体替换，旧的恢复码随之失效
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser.RecoveryCodes = recoveryHashes
+	if err = s.userDAO.Update(mongoCtx, foundUser); err != nil {
+		fmt.Println("保存恢复码失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	return &Practice.RegenerateRecoveryCodesResp{
+		Code:          consts.Success,
+		Msg:           "操作成功",
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// useRecoveryCode 使用恢复码完成两步验证，每个恢复码只能使用一次，返回剩余的恢复码数量
+// 恢复码错误或已使用时计入登录失败次数，与密码错误共用锁定规则
+func (s *AuthServiceImpl) useRecoveryCode(ctx context.Context, foundUser *user.User, code string, clientIP string) (int64, error) {
+	codeHash := util.HashRecoveryCode(code)
+
+	// 原子移除，并发请求中只有一个能使用成功
+	consumed, err := s.userDAO.ConsumeRecoveryCode(ctx, foundUser.ID, codeHash)
+	if err != nil {
+		fmt.Println("使用恢复码失败:", err)
+		return 0, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if !consumed {
+		util.HandleLoginFail(ctx, foundUser.LoginIdentifier(), clientIP)
+		return 0, consts.NewAppErrorWithCode(consts.ErrRecoveryCodeInvalid)
+	}
+
+	// 校验成功，重置失败计数
+	go func() {
+		util.ResetLoginFailEmailCount(context.Background(), foundUser.LoginIdentifier())
+		util.ResetLoginFailIPCount(context.Background(), clientIP)
+	}()
+
+	var remaining int64
+	for _, hash := range foundUser.RecoveryCodes {
+		if hash != codeHash {
+			remaining++
+		}
+	}
+	return remaining, nil
+}
+
+// checkRecoveryCode 校验恢复码是否属于该用户但不消耗，错误时计入登录失败次数
+func checkRecoveryCode(ctx context.Context, foundUser *user.User, code string, clientIP string) error {
+	codeHash := util.HashRecoveryCode(code)
+	for _, hash := range foundUser.RecoveryCodes {
+		if hash == codeHash {
+			return nil
+		}
+	}
+	util.HandleLoginFail(ctx, foundUser.LoginIdentifier(), clientIP)
+	return consts.NewAppErrorWithCode(consts.ErrRecoveryCodeInvalid)
+}
+
+// findCurrentUser 查找当前用户，服务账号不能绑定两步验证或通行密钥
+func (s *AuthServiceImpl) findCurrentUser(ctx context.Context, current *CurrentToken) (*user.User, error) {
+	if current.UserID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+	if current.SubjectType != consts.SubjectTypeUser {
+		return nil, consts.NewAppErrorWithCode(consts.ErrForbidden)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	return s.findUserByHex(mongoCtx, current.UserID)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/totp"
+	"auth/biz/infrastructure/util"
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestEnrollTOTPRequiresEncryptionKey(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	existing := &user.User{Email: "mfa@example.com"}
+	_ = s.userDAO.Create(ctx, existing)
+	current := &CurrentToken{UserID: existing.ID.Hex(), SubjectType: consts.SubjectTypeUser}
+
+	conf := config.GetConfig()
+	conf.MFA.EncryptionKey = ""
+	defer func() { conf.MFA.EncryptionKey = testEncryptionKey }()
+
+	// 未配置加密密钥时拒绝开启，不生成密钥
+	_, err := s.EnrollTOTP(ctx, &Practice.EnrollTOTPReq{}, current)
+	assertAppError(t, err, consts.ErrMFAUnavailable)
+
+	conf.MFA.EncryptionKey = testEncryptionKey
+	resp, err := s.EnrollTOTP(ctx, &Practice.EnrollTOTPReq{}, current)
+	if err != nil {
+		t.Fatalf("开始绑定失败: %v", err)
+	}
+	if resp.Secret == "" {
+		t.Fatal("应返回TOTP密钥")
+	}
+}
+
+func TestLoginWithMFAConsumesOneRecoveryCode(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+
+	secret, _ := totp.GenerateSecret()
+	encrypted, _ := util.EncryptSecret(secret)
+	codes, hashes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing := &user.User{Email: "recovery@example.com", TOTPEnabled: true, TOTPSecret: encrypted, RecoveryCodes: hashes}
+	_ = s.userDAO.Create(ctx, existing)
+
+	challenge, err := s.startMFAChallenge(ctx, existing, "")
+	if err != nil {
+		t.Fatalf("签发mfa token失败: %v", err)
+	}
+
+	// 同一mfa token并发提交不同的恢复码，只有换取到令牌的请求消耗恢复码
+	const workers = 4
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := &Practice.LoginWithMFAReq{MfaToken: challenge.Token, RecoveryCode: codes[i]}
+			_, errs[i] = s.LoginWithMFA(ctx, req, "127.0.0.1", "test")
+		}(i)
+	}
+	wg.Wait()
+
+	passed := 0
+	for _, err := range errs {
+		if err == nil {
+			passed++
+			continue
+		}
+		assertAppError(t, err, consts.ErrMFATokenInvalid)
+	}
+	if passed != 1 {
+		t.Fatalf("应只有1个请求换取到令牌，实际%d个", passed)
+	}
+
+	updated, _ := s.userDAO.FindByID(ctx, existing.ID)
+	if len(updated.RecoveryCodes) != len(hashes)-1 {
+		t.Fatalf("应只消耗1个恢复码，剩余%d个", len(updated.RecoveryCodes))
+	}
+}
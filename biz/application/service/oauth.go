@@ -0,0 +1,494 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/client"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/totp"
+	"auth/biz/infrastructure/util"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// OAuth 2.0错误码（RFC 6749 4.1.2.1、5.2）
+const (
+	OAuthErrInvalidRequest          = "invalid_request"
+	OAuthErrInvalidClient           = "invalid_client"
+	OAuthErrInvalidGrant            = "invalid_grant"
+	OAuthErrInvalidScope            = "invalid_scope"
+	OAuthErrUnauthorizedClient      = "unauthorized_client"
+	OAuthErrUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthErrUnsupportedResponseType = "unsupported_response_type"
+	OAuthErrAccessDenied            = "access_denied"
+	OAuthErrServerError             = "server_error"
+)
+
+// 授权类型
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuthError OAuth协议错误，按RFC 6749以error/error_description返回
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	Status      int    `json:"-"` // HTTP状态码
+	RedirectURI string `json:"-"` // 非空时通过重定向把错误返回给客户端
+	State       string `json:"-"`
+}
+
+// Error 实现error接口
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// RedirectURL 携带错误信息的回调地址
+func (e *OAuthError) RedirectURL() string {
+	return buildRedirectURL(e.RedirectURI, map[string]string{
+		"error":             e.Code,
+		"error_description": e.Description,
+		"state":             e.State,
+	})
+}
+
+// newOAuthError 创建OAuth协议错误
+func newOAuthError(code string, description string) *OAuthError {
+	status := http.StatusBadRequest
+	if code == OAuthErrInvalidClient {
+		status = http.StatusUnauthorized
+	}
+	return &OAuthError{Code: code, Description: description, Status: status}
+}
+
+// AuthorizeReq 授权请求（RFC 6749 4.1.1），GET时来自查询参数，POST时来自授权页表单
+type AuthorizeReq struct {
+	ResponseType        string `form:"response_type" query:"response_type"`
+	ClientID            string `form:"client_id" query:"client_id"`
+	RedirectURI         string `form:"redirect_uri" query:"redirect_uri"`
+	Scope               string `form:"scope" query:"scope"`
+	State               string `form:"state" query:"state"`
+	CodeChallenge       string `form:"code_challenge" query:"code_challenge"`
+	CodeChallengeMethod string `form:"code_challenge_method" query:"code_challenge_method"`
+	Nonce               string `form:"nonce" query:"nonce"`
+
+	// 授权页表单提交的字段
+	Username string `form:"username"` // 邮箱或手机号
+	Password string `form:"password"`
+	TOTPCode string `form:"totp_code"` // 已开启两步验证的用户需填写动态验证码，无法使用验证器App时填写恢复码
+	Action   string `form:"action"`    // approve-同意授权，deny-拒绝
+}
+
+// AuthorizePrompt 授权页展示的信息
+type AuthorizePrompt struct {
+	ClientName string
+	Scopes     []string
+	Request    *AuthorizeReq // 原样回填到授权页表单
+	Error      string        // 登录失败时的提示
+}
+
+// TokenReq 令牌请求（RFC 6749 4.1.3、6），以表单提交
+type TokenReq struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+// TokenResp 令牌响应（RFC 6749 5.1）
+type TokenResp struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"` // 授权范围包含openid时返回
+}
+
+// authorizeContext 校验通过的授权请求
+type authorizeContext struct {
+	client      *client.Client
+	redirectURI string // 实际回调地址，请求未携带redirect_uri时为客户端唯一注册的地址
+	scope       string
+}
+
+// PrepareAuthorize 校验授权请求，返回授权页展示的信息
+func (s *AuthServiceImpl) PrepareAuthorize(ctx context.Context, req *AuthorizeReq) (*AuthorizePrompt, error) {
+	authCtx, err := s.validateAuthorizeRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthorizePrompt{
+		ClientName: authCtx.client.Name,
+		Scopes:     strings.Fields(authCtx.scope),
+		Request:    req,
+	}, nil
+}
+
+// Authorize 处理授权页提交：校验账号密码后签发授权码，返回携带授权码的回调地址
+// 账号密码错误时返回*consts.AppError，由调用方重新展示授权页
+func (s *AuthServiceImpl) Authorize(ctx context.Context, req *AuthorizeReq, clientIP string) (string, error) {
+	authCtx, err := s.validateAuthorizeRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	// 用户拒绝授权
+	if req.Action == "deny" {
+		return "", authCtx.redirectError(OAuthErrAccessDenied, "用户拒绝了授权请求", req.State)
+	}
+
+	// 授权页只有一个输入框，包含@的按邮箱处理，否则按手机号处理
+	id, err := authorizeIdentity(req.Username)
+	if err != nil {
+		return "", err
+	}
+
+	// 与登录接口使用相同的账号密码校验和登录锁定逻辑
+	foundUser, err := s.authenticate(ctx, id, req.Password, clientIP)
+	if err != nil {
+		return "", err
+	}
+
+	// 已开启两步验证的用户需同时提交动态验证码或恢复码
+	if foundUser.TOTPEnabled {
+		if err = s.verifyAuthorizeMFA(ctx, foundUser, strings.TrimSpace(req.TOTPCode), clientIP); err != nil {
+			return "", err
+		}
+	}
+
+	// 生成授权码
+	code, err := util.GenerateOAuthCode()
+	if err != nil {
+		return "", consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	err = util.SaveOAuthCode(ctx, code, &util.OAuthCodeData{
+		ClientID:      authCtx.client.ClientID,
+		RedirectURI:   req.RedirectURI,
+		UserID:        foundUser.ID.Hex(),
+		Email:         foundUser.Email,
+		Scope:         authCtx.scope,
+		CodeChallenge: req.CodeChallenge,
+		Nonce:         req.Nonce,
+		AuthTime:      time.Now().Unix(),
+	})
+	if err != nil {
+		fmt.Println("存储授权码失败:", err)
+		return "", consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return buildRedirectURL(authCtx.redirectURI, map[string]string{
+		"code":  code,
+		"state": req.State,
+	}), nil
+}
+
+// authorizeIdentity 解析授权页填写的邮箱或手机号
+func authorizeIdentity(username string) (*loginIdentity, error) {
+	if strings.Contains(username, "@") {
+		return resolveIdentity(username, "")
+	}
+	return resolveIdentity("", username)
+}
+
+// verifyAuthorizeMFA 校验授权页填写的动态验证码，不是6位数字时按恢复码校验
+func (s *AuthServiceImpl) verifyAuthorizeMFA(ctx context.Context, foundUser *user.User, code string, clientIP string) error {
+	if code == "" {
+		return consts.NewAppError(consts.ErrMFACodeInvalid, "已开启两步验证，请输入动态验证码或恢复码")
+	}
+	if totp.IsCode(code) {
+		return verifyTOTP(ctx, foundUser, code, clientIP)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	_, err := s.useRecoveryCode(mongoCtx, foundUser, code, clientIP)
+	return err
+}
+
+// validateAuthorizeRequest 校验授权请求
+// 客户端或回调地址无效时不能重定向，直接向用户展示错误；其余错误通过回调地址返回给客户端
+func (s *AuthServiceImpl) validateAuthorizeRequest(ctx context.Context, req *AuthorizeReq) (*authorizeContext, error) {
+	if req.ClientID == "" {
+		return nil, newOAuthError(OAuthErrInvalidRequest, "缺少client_id")
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundClient, err := s.clientDAO.FindByClientID(mongoCtx, req.ClientID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if foundClient == nil {
+		return nil, newOAuthError(OAuthErrInvalidClient, "客户端不存在")
+	}
+
+	// 回调地址必须与注册的地址完全一致；只注册了一个地址时允许省略
+	authCtx := &authorizeContext{client: foundClient}
+	if req.RedirectURI == "" && len(foundClient.RedirectURIs) == 1 {
+		authCtx.redirectURI = foundClient.RedirectURIs[0]
+	} else if slices.Contains(foundClient.RedirectURIs, req.RedirectURI) {
+		authCtx.redirectURI = req.RedirectURI
+	} else {
+		return nil, newOAuthError(OAuthErrInvalidRequest, "redirect_uri与注册的回调地址不匹配")
+	}
+
+	if req.ResponseType != "code" {
+		return nil, authCtx.redirectError(OAuthErrUnsupportedResponseType, "只支持授权码模式", req.State)
+	}
+
+	// 授权范围必须在客户端允许的范围内，未指定时使用客户端允许的全部范围
+	// 未配置非对称签名密钥时不能签发ID Token，不支持openid
+	if req.Scope == "" {
+		scopes := slices.Clone(foundClient.Scopes)
+		if !oidcEnabled() {
+			scopes = slices.DeleteFunc(scopes, func(scope string) bool { return scope == consts.ScopeOpenID })
+		}
+		authCtx.scope = strings.Join(scopes, " ")
+	} else {
+		scopes := strings.Fields(req.Scope)
+		for _, scope := range scopes {
+			if !slices.Contains(foundClient.Scopes, scope) {
+				return nil, authCtx.redirectError(OAuthErrInvalidScope, "不允许申请的授权范围: "+scope, req.State)
+			}
+			if scope == consts.ScopeOpenID && !oidcEnabled() {
+				return nil, authCtx.redirectError(OAuthErrInvalidScope, "未配置非对称签名密钥，不支持openid", req.State)
+			}
+		}
+		authCtx.scope = strings.Join(scopes, " ")
+	}
+
+	// PKCE：公开客户端必须使用，只支持S256
+	if req.CodeChallenge == "" {
+		if foundClient.Type == consts.ClientTypePublic {
+			return nil, authCtx.redirectError(OAuthErrInvalidRequest, "公开客户端必须使用PKCE", req.State)
+		}
+	} else if req.CodeChallengeMethod != consts.PKCEMethodS256 || len(req.CodeChallenge) != 43 {
+		return nil, authCtx.redirectError(OAuthErrInvalidRequest, "code_challenge无效，只支持S256", req.State)
+	}
+
+	return authCtx, nil
+}
+
+// redirectError 创建通过回调地址返回给客户端的错误
+func (a *authorizeContext) redirectError(code string, description string, state string) *OAuthError {
+	err := newOAuthError(code, description)
+	err.RedirectURI = a.redirectURI
+	err.State = state
+	return err
+}
+
+// Token 令牌端点，支持authorization_code、refresh_token和client_credentials三种授权类型
+func (s *AuthServiceImpl) Token(ctx context.Context, req *TokenReq, clientIP string, userAgent string) (*TokenResp, error) {
+	// client_credentials由服务账号使用，单独认证
+	if req.GrantType == GrantTypeClientCredentials {
+		return s.clientCredentialsGrant(ctx, req)
+	}
+
+	// 认证客户端
+	foundClient, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens *tokenPair
+	var scope string
+	switch req.GrantType {
+	case GrantTypeAuthorizationCode:
+		tokens, scope, err = s.exchangeAuthorizationCode(ctx, req, foundClient, clientIP, userAgent)
+	case GrantTypeRefreshToken:
+		tokens, scope, err = s.refreshClientToken(ctx, req, foundClient)
+	case "":
+		return nil, newOAuthError(OAuthErrInvalidRequest, "缺少grant_type")
+	default:
+		return nil, newOAuthError(OAuthErrUnsupportedGrantType, "不支持的授权类型: "+req.GrantType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenResp{
+		AccessToken:  tokens.AccessToken,
+		TokenType:    consts.TokenType,
+		ExpiresIn:    tokens.AccessExpire - time.Now().Unix(),
+		RefreshToken: tokens.RefreshToken,
+		Scope:        scope,
+		IDToken:      tokens.IDToken,
+	}, nil
+}
+
+// authenticateClient 认证令牌请求的客户端，机密客户端必须提供正确的client_secret
+func (s *AuthServiceImpl) authenticateClient(ctx context.Context, clientID string, clientSecret string) (*client.Client, error) {
+	if clientID == "" {
+		return nil, newOAuthError(OAuthErrInvalidClient, "缺少client_id")
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundClient, err := s.clientDAO.FindByClientID(mongoCtx, clientID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if foundClient == nil {
+		return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+	}
+
+	if foundClient.Type == consts.ClientTypeConfidential {
+		err = bcrypt.CompareHashAndPassword([]byte(foundClient.SecretHash), []byte(clientSecret))
+		if clientSecret == "" || err != nil {
+			return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+		}
+	}
+
+	return foundClient, nil
+}
+
+// exchangeAuthorizationCode 使用授权码换取令牌
+func (s *AuthServiceImpl) exchangeAuthorizationCode(ctx context.Context, req *TokenReq, foundClient *client.Client, clientIP string, userAgent string) (*tokenPair, string, error) {
+	if req.Code == "" {
+		return nil, "", newOAuthError(OAuthErrInvalidRequest, "缺少code")
+	}
+
+	// 取出并删除授权码
+	data, err := util.ConsumeOAuthCode(ctx, req.Code)
+	if err != nil {
+		fmt.Println("读取授权码失败:", err)
+		return nil, "", consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if data == nil {
+		// 授权码被重复使用时，吊销第一次换取的令牌（RFC 6749 4.1.2）
+		sessionID, err := util.GetUsedOAuthCodeSession(ctx, req.Code)
+		if err != nil {
+			fmt.Println("检查授权码重放失败:", err)
+			return nil, "", consts.NewAppErrorWithCode(consts.ErrRedis)
+		}
+
+		if id, err := primitive.ObjectIDFromHex(sessionID); err == nil {
+			fmt.Println("检测到授权码重放，吊销会话:", sessionID)
+			if err = s.revokeSession(mongoCtx, id); err != nil {
+				return nil, "", err
+			}
+		}
+		return nil, "", newOAuthError(OAuthErrInvalidGrant, "授权码无效或已过期")
+	}
+
+	// 授权码必须由申请它的客户端、以相同的回调地址使用
+	if data.ClientID != foundClient.ClientID || data.RedirectURI != req.RedirectURI {
+		return nil, "", newOAuthError(OAuthErrInvalidGrant, "授权码与客户端或回调地址不匹配")
+	}
+
+	// 校验PKCE
+	if data.CodeChallenge != "" && !verifyCodeChallenge(req.CodeVerifier, data.CodeChallenge) {
+		return nil, "", newOAuthError(OAuthErrInvalidGrant, "code_verifier校验失败")
+	}
+
+	userID, err := primitive.ObjectIDFromHex(data.UserID)
+	if err != nil {
+		return nil, "", consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	// 为本次授权创建会话，客户端的refresh token属于该会话
+	sessionID, err := s.createSession(mongoCtx, userID, clientIP, userAgent)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err = util.MarkOAuthCodeUsed(ctx, req.Code, sessionID); err != nil {
+		fmt.Println("记录已使用授权码失败:", err)
+		// 非致命错误，继续流程
+	}
+
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    data.UserID,
+		Email:     data.Email,
+		SessionID: sessionID,
+		ClientID:  data.ClientID,
+		Scope:     data.Scope,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 申请了openid时同时签发ID Token
+	if slices.Contains(strings.Fields(data.Scope), consts.ScopeOpenID) {
+		tokens.IDToken, err = s.issueIDToken(mongoCtx, foundClient, data)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return tokens, data.Scope, nil
+}
+
+// refreshClientToken 客户端使用refresh token换取新令牌，授权范围保持不变
+func (s *AuthServiceImpl) refreshClientToken(ctx context.Context, req *TokenReq, foundClient *client.Client) (*tokenPair, string, error) {
+	if req.RefreshToken == "" {
+		return nil, "", newOAuthError(OAuthErrInvalidRequest, "缺少refresh_token")
+	}
+
+	tokens, data, err := s.rotateRefreshToken(ctx, req.RefreshToken, foundClient.ClientID)
+	if err != nil {
+		var appErr *consts.AppError
+		if errors.As(err, &appErr) && (appErr.Code == consts.ErrRefreshInvalid || appErr.Code == consts.ErrRefreshReused) {
+			return nil, "", newOAuthError(OAuthErrInvalidGrant, appErr.Msg)
+		}
+		return nil, "", err
+	}
+
+	return tokens, data.Scope, nil
+}
+
+// verifyCodeChallenge 校验PKCE：BASE64URL(SHA256(code_verifier)) == code_challenge（RFC 7636 4.6）
+func verifyCodeChallenge(verifier string, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// buildRedirectURL 在回调地址上追加查询参数，保留地址中原有的参数，忽略空值
+func buildRedirectURL(redirectURI string, params map[string]string) string {
+	parsed, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+
+	query := parsed.Query()
+	for key, value := range params {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
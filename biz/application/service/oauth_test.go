@@ -0,0 +1,56 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/totp"
+	"auth/biz/infrastructure/util"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAuthorizeIdentity(t *testing.T) {
+	tests := map[string]*loginIdentity{
+		"User@Example.com":  {Type: consts.IdentityTypeEmail, Identifier: "user@example.com"},
+		"+86 138 0013 8000": {Type: consts.IdentityTypePhone, Identifier: "+8613800138000"},
+	}
+	for username, want := range tests {
+		got, err := authorizeIdentity(username)
+		if err != nil || *got != *want {
+			t.Errorf("authorizeIdentity(%q) = %+v, %v, want %+v", username, got, err, want)
+		}
+	}
+
+	_, err := authorizeIdentity("not-a-phone")
+	assertAppError(t, err, consts.ErrPhoneInvalid)
+}
+
+func TestAuthorizeAcceptsRecoveryCode(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+
+	secret, _ := totp.GenerateSecret()
+	encrypted, _ := util.EncryptSecret(secret)
+	codes, hashes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing := &user.User{Phone: "+8613800138000", TOTPEnabled: true, TOTPSecret: encrypted, RecoveryCodes: hashes}
+	_ = s.userDAO.Create(ctx, existing)
+
+	// 同一输入框中可以填写动态验证码或恢复码
+	code, _ := totp.Code(secret, totp.Step(time.Now()))
+	if err = s.verifyAuthorizeMFA(ctx, existing, code, "127.0.0.1"); err != nil {
+		t.Fatalf("动态验证码校验失败: %v", err)
+	}
+	if err = s.verifyAuthorizeMFA(ctx, existing, codes[0], "127.0.0.1"); err != nil {
+		t.Fatalf("恢复码校验失败: %v", err)
+	}
+
+	// 恢复码只能使用一次
+	err = s.verifyAuthorizeMFA(ctx, existing, codes[0], "127.0.0.1")
+	assertAppError(t, err, consts.ErrRecoveryCodeInvalid)
+	err = s.verifyAuthorizeMFA(ctx, existing, "", "127.0.0.1")
+	assertAppError(t, err, consts.ErrMFACodeInvalid)
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/mapper/client"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OAuthErrInsufficientScope token缺少访问资源所需的授权范围（RFC 6750 3.1）
+const OAuthErrInsufficientScope = "insufficient_scope"
+
+// OpenIDConfiguration OIDC发现文档（OpenID Connect Discovery 1.0）
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// UserInfoResp OIDC用户信息，按token的授权范围返回对应字段
+type UserInfoResp struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	UpdatedAt     int64  `json:"updated_at,omitempty"`
+}
+
+// oidcEnabled 是否支持OpenID Connect，ID Token必须使用非对称密钥签名，客户端通过JWKS验证
+func oidcEnabled() bool {
+	ring, err := jwt.GetKeyring()
+	return err == nil && ring.Asymmetric()
+}
+
+// GetOpenIDConfiguration 生成OIDC发现文档，各端点地址基于配置的issuer
+// 未配置非对称签名密钥时不支持OIDC，返回资源不存在
+func (s *AuthServiceImpl) GetOpenIDConfiguration(ctx context.Context) (*OpenIDConfiguration, error) {
+	ring, err := jwt.GetKeyring()
+	if err != nil {
+		return nil, err
+	}
+	if !ring.Asymmetric() {
+		return nil, consts.NewAppError(consts.ErrNotFound, "未配置非对称签名密钥，不支持OpenID Connect")
+	}
+
+	issuer := strings.TrimSuffix(config.GetConfig().JWT.Issuer, "/")
+	return &OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/oauth/authorize",
+		TokenEndpoint:                     issuer + "/oauth/token",
+		UserinfoEndpoint:                  issuer + "/userinfo",
+		JwksURI:                           issuer + "/.well-known/jwks.json",
+		ScopesSupported:                   []string{consts.ScopeOpenID, consts.ScopeEmail, consts.ScopeProfile},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{ring.SigningAlgorithm()},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{consts.PKCEMethodS256},
+		ClaimsSupported:                   []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "email", "email_verified", "updated_at"},
+	}, nil
+}
+
+// UserInfo OIDC用户信息端点，token必须包含openid授权范围
+func (s *AuthServiceImpl) UserInfo(ctx context.Context, current *CurrentToken) (*UserInfoResp, error) {
+	if !slices.Contains(strings.Fields(current.Scope), consts.ScopeOpenID) {
+		err := newOAuthError(OAuthErrInsufficientScope, "token缺少openid授权范围")
+		err.Status = http.StatusForbidden
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.findUserByHex(mongoCtx, current.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildUserInfo(mongoCtx, foundUser, current.Scope)
+}
+
+// issueIDToken 为授权码换取令牌签发ID Token
+func (s *AuthServiceImpl) issueIDToken(ctx context.Context, foundClient *client.Client, data *util.OAuthCodeData) (string, error) {
+	foundUser, err := s.findUserByHex(ctx, data.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := s.buildUserInfo(ctx, foundUser, data.Scope)
+	if err != nil {
+		return "", err
+	}
+	claims := jwt.IDTokenClaims{
+		Nonce:         data.Nonce,
+		AuthTime:      data.AuthTime,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		UpdatedAt:     info.UpdatedAt,
+	}
+	claims.Subject = info.Sub
+	claims.Audience = foundClient.ClientID
+
+	idToken, err := jwt.GenerateIDToken(claims)
+	if err != nil {
+		fmt.Println("签发ID Token失败:", err)
+		return "", consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+	}
+	return idToken, nil
+}
+
+// findUserByHex 根据十六进制用户ID查找用户，用户不存在时返回错误
+func (s *AuthServiceImpl) findUserByHex(ctx context.Context, userID string) (*user.User, error) {
+	id, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	foundUser, err := s.userDAO.FindByID(ctx, id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if foundUser == nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUserNotExist)
+	}
+	return foundUser, nil
+}
+
+// buildUserInfo 按授权范围组装用户信息
+// email_verified取自邮箱登录标识的验证状态，没有对应登录标识的邮箱视为未验证
+func (s *AuthServiceImpl) buildUserInfo(ctx context.Context, foundUser *user.User, scope string) (*UserInfoResp, error) {
+	scopes := strings.Fields(scope)
+	info := &UserInfoResp{Sub: foundUser.ID.Hex()}
+	if slices.Contains(scopes, consts.ScopeEmail) && foundUser.Email != "" {
+		found, err := s.identityDAO.FindByIdentifier(ctx, consts.IdentityTypeEmail, util.NormalizeEmail(foundUser.Email))
+		if err != nil {
+			fmt.Println("查找邮箱登录标识失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+		}
+		info.Email = foundUser.Email
+		info.EmailVerified = found != nil && found.UserID == foundUser.ID && found.Verified
+	}
+	if slices.Contains(scopes, consts.ScopeProfile) && !foundUser.UpdateTime.IsZero() {
+		info.UpdatedAt = foundUser.UpdateTime.Unix()
+	}
+	return info, nil
+}
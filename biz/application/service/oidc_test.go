@@ -0,0 +1,60 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestUserInfoEmailVerified(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	scope := consts.ScopeOpenID + " " + consts.ScopeEmail
+
+	verified := &user.User{Email: "verified@example.com"}
+	_ = s.userDAO.Create(ctx, verified)
+	_ = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID: verified.ID, Type: consts.IdentityTypeEmail, Identifier: verified.Email, Verified: true,
+	})
+	unverified := &user.User{Email: "unverified@example.com"}
+	_ = s.userDAO.Create(ctx, unverified)
+	_ = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID: unverified.ID, Type: consts.IdentityTypeEmail, Identifier: unverified.Email,
+	})
+	// 没有邮箱登录标识的邮箱视为未验证
+	missing := &user.User{Email: "missing@example.com"}
+	_ = s.userDAO.Create(ctx, missing)
+
+	tests := []struct {
+		user *user.User
+		want bool
+	}{
+		{verified, true},
+		{unverified, false},
+		{missing, false},
+	}
+	for _, tt := range tests {
+		info, err := s.buildUserInfo(ctx, tt.user, scope)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Email != tt.user.Email || info.EmailVerified != tt.want {
+			t.Errorf("%s: email_verified = %v, want %v", tt.user.Email, info.EmailVerified, tt.want)
+		}
+	}
+}
+
+func TestOIDCRequiresAsymmetricKey(t *testing.T) {
+	s := newTestService()
+
+	// 测试配置只有HS256密钥
+	if _, err := jwt.GenerateIDToken(jwt.IDTokenClaims{}); !errors.Is(err, jwt.ErrIDTokenKey) {
+		t.Fatalf("err = %v, want ErrIDTokenKey", err)
+	}
+	_, err := s.GetOpenIDConfiguration(context.Background())
+	assertAppError(t, err, consts.ErrNotFound)
+}
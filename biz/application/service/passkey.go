@@ -0,0 +1,405 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/passkey"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/util"
+	"auth/biz/infrastructure/webauthn"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// defaultPasskeyName 注册时未设置名称使用的默认名称
+const defaultPasskeyName = "通行密钥"
+
+// BeginPasskeyRegistration 开始注册通行密钥，生成challenge并暂存到Redis
+// 通行密钥可直接登录，需要重新输入当前密码或动态验证码，防止令牌泄露后被注册攻击者的通行密钥
+func (s *AuthServiceImpl) BeginPasskeyRegistration(ctx context.Context, req *Practice.BeginPasskeyRegistrationReq, current *CurrentToken, clientIP string) (*Practice.BeginPasskeyRegistrationResp, error) {
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目录用户不能使用通行密钥登录，也不能注册
+	if err = checkDirectoryLogin(foundUser); err != nil {
+		return nil, err
+	}
+
+	if err = s.reauthenticate(ctx, foundUser, req.Password, req.TotpCode, clientIP); err != nil {
+		return nil, err
+	}
+
+	passkeyUser, err := s.loadPasskeyUser(foundUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(passkeyUser.Passkeys) >= consts.PasskeyMaxPerUser {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyLimit)
+	}
+
+	options, sessionData, err := webauthn.BeginRegistration(passkeyUser)
+	if err != nil {
+		fmt.Println("生成通行密钥注册参数失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	ceremonyID, err := util.SavePasskeyCeremony(ctx, consts.PasskeyCeremonyRegister, sessionData)
+	if err != nil {
+		fmt.Println("存储通行密钥注册仪式失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return &Practice.BeginPasskeyRegistrationResp{
+		Code:       consts.Success,
+		Msg:        "操作成功",
+		CeremonyID: ceremonyID,
+		Options:    options,
+	}, nil
+}
+
+// FinishPasskeyRegistration 校验验证器返回的凭证并保存通行密钥
+// 每个注册仪式只能提交一次，且只能由发起注册的用户完成
+func (s *AuthServiceImpl) FinishPasskeyRegistration(ctx context.Context, req *Practice.FinishPasskeyRegistrationReq, current *CurrentToken) (*Practice.FinishPasskeyRegistrationResp, error) {
+	if req.CeremonyID == "" || len(req.Credential) == 0 {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	if utf8.RuneCountInString(name) > consts.PasskeyNameMaxLength {
+		return nil, consts.NewAppError(consts.ErrParams, fmt.Sprintf("通行密钥名称不能超过%d个字符", consts.PasskeyNameMaxLength))
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	// 原子取出注册仪式，challenge只能使用一次
+	sessionData, err := util.ConsumePasskeyCeremony(ctx, consts.PasskeyCeremonyRegister, req.CeremonyID)
+	if err != nil {
+		fmt.Println("获取通行密钥注册仪式失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if sessionData == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyCeremony)
+	}
+
+	passkeyUser, err := s.loadPasskeyUser(foundUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(passkeyUser.Passkeys) >= consts.PasskeyMaxPerUser {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyLimit)
+	}
+
+	// 校验challenge、origin、RP ID和签名，仪式不属于当前用户时同样失败
+	created, err := webauthn.FinishRegistration(passkeyUser, sessionData, req.Credential)
+	if err != nil {
+		fmt.Println("通行密钥注册校验失败:", describePasskeyError(err))
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyInvalid)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	// 凭证ID全局唯一，同一验证器不能重复注册
+	existing, err := s.passkeyDAO.FindByCredentialID(mongoCtx, created.CredentialID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if existing != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyExists)
+	}
+
+	created.Name = name
+	if err = s.passkeyDAO.Create(mongoCtx, created); err != nil {
+		fmt.Println("保存通行密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	return &Practice.FinishPasskeyRegistrationResp{
+		Code:    consts.Success,
+		Msg:     "通行密钥注册成功",
+		Passkey: toPasskeyInfo(created),
+	}, nil
+}
+
+// ListPasskeys 获取当前用户的通行密钥列表
+func (s *AuthServiceImpl) ListPasskeys(ctx context.Context, req *Practice.ListPasskeysReq, current *CurrentToken) (*Practice.ListPasskeysResp, error) {
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	passkeys, err := s.passkeyDAO.FindByUserID(mongoCtx, foundUser.ID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 转换为响应结构
+	infos := make([]*Practice.PasskeyInfo, 0, len(passkeys))
+	for _, item := range passkeys {
+		infos = append(infos, toPasskeyInfo(item))
+	}
+
+	return &Practice.ListPasskeysResp{
+		Code:     consts.Success,
+		Msg:      "获取通行密钥列表成功",
+		Passkeys: infos,
+	}, nil
+}
+
+// DeletePasskey 删除当前用户的指定通行密钥
+func (s *AuthServiceImpl) DeletePasskey(ctx context.Context, req *Practice.DeletePasskeyReq, current *CurrentToken) (*Practice.DeletePasskeyResp, error) {
+	passkeyID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	foundUser, err := s.findCurrentUser(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	// 按用户过滤删除，不存在或属于其他用户时统一返回不存在
+	deleted, err := s.passkeyDAO.Delete(mongoCtx, foundUser.ID, passkeyID)
+	if err != nil {
+		fmt.Println("删除通行密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if !deleted {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	return &Practice.DeletePasskeyResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "通行密钥已删除",
+	}, nil
+}
+
+// BeginPasskeyLogin 开始通行密钥登录，使用可发现凭证，不需要输入邮箱
+func (s *AuthServiceImpl) BeginPasskeyLogin(ctx context.Context) (*Practice.BeginPasskeyLoginResp, error) {
+	options, sessionData, err := webauthn.BeginLogin()
+	if err != nil {
+		fmt.Println("生成通行密钥登录参数失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	ceremonyID, err := util.SavePasskeyCeremony(ctx, consts.PasskeyCeremonyLogin, sessionData)
+	if err != nil {
+		fmt.Println("存储通行密钥登录仪式失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return &Practice.BeginPasskeyLoginResp{
+		Code:       consts.Success,
+		Msg:        "操作成功",
+		CeremonyID: ceremonyID,
+		Options:    options,
+	}, nil
+}
+
+// FinishPasskeyLogin 校验通行密钥断言并签发令牌
+// 校验失败计入IP维度的登录失败次数；签名计数未增长时拒绝登录
+// 已开启两步验证且本次断言未完成用户验证（指纹、PIN等）时，仍需要动态验证码
+func (s *AuthServiceImpl) FinishPasskeyLogin(ctx context.Context, req *Practice.FinishPasskeyLoginReq, clientIP string, userAgent string) (*Practice.LoginResp, error) {
+	if req.CeremonyID == "" || len(req.Credential) == 0 {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	scope, err := resolveLoginScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查IP是否被锁定，此时还不知道是哪个账号
+	isIPLocked, err := util.IsLoginLockedByIP(ctx, clientIP)
+	if err != nil {
+		fmt.Println("检查IP锁定状态失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if isIPLocked {
+		return nil, consts.NewAppErrorWithCode(consts.ErrLoginLocked)
+	}
+
+	// 原子取出登录仪式，challenge只能使用一次
+	sessionData, err := util.ConsumePasskeyCeremony(ctx, consts.PasskeyCeremonyLogin, req.CeremonyID)
+	if err != nil {
+		fmt.Println("获取通行密钥登录仪式失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if sessionData == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyCeremony)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	// 按断言中的user handle加载用户，数据库错误单独返回，不作为验证失败处理
+	var foundUser *user.User
+	var lookupErr error
+	result, err := webauthn.FinishLogin(sessionData, req.Credential, func(userID primitive.ObjectID) (*webauthn.User, error) {
+		found, err := s.userDAO.FindByID(mongoCtx, userID)
+		if err != nil {
+			lookupErr = consts.NewAppErrorWithCode(consts.ErrMongo)
+			return nil, err
+		}
+		if found == nil {
+			return nil, webauthn.ErrCredentialNotFound
+		}
+
+		passkeyUser, err := s.loadPasskeyUser(found)
+		if err != nil {
+			lookupErr = err
+			return nil, err
+		}
+		foundUser = found
+		return passkeyUser, nil
+	})
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+
+	if err != nil {
+		fmt.Println("通行密钥登录校验失败:", describePasskeyError(err))
+		util.HandleLoginFailForNonExistentUser(ctx, clientIP)
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyInvalid)
+	}
+
+	// 检查邮箱和IP是否被锁定
+	if err = checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP); err != nil {
+		return nil, err
+	}
+
+	// 注册通行密钥后才改由目录管理的用户
+	if err = checkDirectoryLogin(foundUser); err != nil {
+		return nil, err
+	}
+
+	// 签名计数未增长，验证器可能已被复制，不更新计数并拒绝登录
+	if result.CloneWarning {
+		fmt.Printf("通行密钥签名计数异常 - 用户: %s, 通行密钥: %s, 记录的计数: %d\n",
+			foundUser.ID.Hex(), result.Passkey.ID.Hex(), result.Passkey.SignCount)
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasskeyCloned)
+	}
+
+	if err = s.passkeyDAO.UpdateUsage(mongoCtx, result.Passkey); err != nil {
+		fmt.Println("更新通行密钥签名计数失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 校验成功，重置失败计数
+	go func() {
+		util.ResetLoginFailEmailCount(context.Background(), foundUser.LoginIdentifier())
+		util.ResetLoginFailIPCount(context.Background(), clientIP)
+	}()
+
+	// 未完成用户验证的通行密钥只相当于持有验证器，已开启两步验证时只返回mfa token
+	if foundUser.TOTPEnabled && !result.Passkey.UserVerified {
+		challenge, err := s.startMFAChallenge(ctx, foundUser, scope)
+		if err != nil {
+			return nil, err
+		}
+		return &Practice.LoginResp{
+			Scope:                 scope,
+			PasswordResetRequired: foundUser.PasswordResetRequired,
+			MfaRequired:           true,
+			MfaToken:              challenge.Token,
+			MfaExpire:             challenge.Expire,
+		}, nil
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, foundUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    foundUser.ID.Hex(),
+		Email:     foundUser.Email,
+		SessionID: sessionID,
+		Scope:     scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.LoginResp{
+		AccessToken:           tokens.AccessToken,
+		AccessExpire:          tokens.AccessExpire,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshExpire:         tokens.RefreshExpire,
+		Scope:                 scope,
+		PasswordResetRequired: foundUser.PasswordResetRequired,
+	}, nil
+}
+
+// loadPasskeyUser 加载用户已注册的通行密钥
+func (s *AuthServiceImpl) loadPasskeyUser(foundUser *user.User) (*webauthn.User, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	passkeys, err := s.passkeyDAO.FindByUserID(mongoCtx, foundUser.ID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	return &webauthn.User{
+		ID:       foundUser.ID,
+		Name:     foundUser.LoginIdentifier(),
+		Passkeys: passkeys,
+	}, nil
+}
+
+// toPasskeyInfo 转换为响应结构，不返回凭证ID和公钥
+func toPasskeyInfo(item *passkey.Passkey) *Practice.PasskeyInfo {
+	info := &Practice.PasskeyInfo{
+		Id:             item.ID.Hex(),
+		Name:           item.Name,
+		Transports:     item.Transports,
+		BackupEligible: item.BackupEligible,
+		BackupState:    item.BackupState,
+		CreateTime:     item.CreateTime.Unix(),
+	}
+	if !item.LastUsedTime.IsZero() {
+		info.LastUsedTime = item.LastUsedTime.Unix()
+	}
+	return info
+}
+
+// describePasskeyError 输出webauthn库错误的详细原因，便于排查前端传参问题
+func describePasskeyError(err error) string {
+	var protocolErr *protocol.Error
+	if errors.As(err, &protocolErr) && protocolErr.DevInfo != "" {
+		return protocolErr.Details + ": " + protocolErr.DevInfo
+	}
+	return err.Error()
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/user"
+	"context"
+	"testing"
+)
+
+// newPasskeyUser 创建有密码的用户，返回当前令牌
+func newPasskeyUser(t *testing.T, s *AuthServiceImpl, existing *user.User) *CurrentToken {
+	t.Helper()
+	passwordHash, err := hashPassword("current-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing.Password = passwordHash
+	_ = s.userDAO.Create(context.Background(), existing)
+	return &CurrentToken{UserID: existing.ID.Hex(), SubjectType: consts.SubjectTypeUser}
+}
+
+func TestBeginPasskeyRegistrationRequiresReauthentication(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	current := newPasskeyUser(t, s, &user.User{Email: "passkey@example.com"})
+
+	begin := func(req *Practice.BeginPasskeyRegistrationReq) error {
+		_, err := s.BeginPasskeyRegistration(ctx, req, current, "127.0.0.1")
+		return err
+	}
+
+	// 只有令牌不能注册通行密钥
+	assertAppError(t, begin(&Practice.BeginPasskeyRegistrationReq{}), consts.ErrParams)
+	assertAppError(t, begin(&Practice.BeginPasskeyRegistrationReq{Password: "wrong-password"}), consts.ErrPasswordIncorrect)
+	assertAppError(t, begin(&Practice.BeginPasskeyRegistrationReq{TotpCode: "000000"}), consts.ErrMFANotEnabled)
+
+	resp, err := s.BeginPasskeyRegistration(ctx, &Practice.BeginPasskeyRegistrationReq{Password: "current-password"}, current, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("开始注册失败: %v", err)
+	}
+	if resp.CeremonyID == "" || resp.Options == nil {
+		t.Fatal("应返回注册参数")
+	}
+}
+
+func TestPasskeyRegistrationUsesLoginIdentifier(t *testing.T) {
+	s := newTestService()
+
+	// 使用手机号注册、没有邮箱的用户，验证器中显示手机号
+	current := newPasskeyUser(t, s, &user.User{Phone: "+8613800138000"})
+	resp, err := s.BeginPasskeyRegistration(context.Background(), &Practice.BeginPasskeyRegistrationReq{Password: "current-password"}, current, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("开始注册失败: %v", err)
+	}
+	if resp.Options.Response.User.Name != "+8613800138000" || resp.Options.Response.User.DisplayName != "+8613800138000" {
+		t.Fatalf("user = %+v", resp.Options.Response.User)
+	}
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/email"
+	"auth/biz/infrastructure/ldap"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/password"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ForgotPassword 忘记密码，向邮箱发送重置密码验证码
+// 与SendVerificationCode共用冷却和冻结限制；邮箱未注册时同样返回成功，避免暴露账号是否存在
+func (s *AuthServiceImpl) ForgotPassword(ctx context.Context, req *Practice.ForgotPasswordReq) (*Practice.ForgotPasswordResp, error) {
+	id := emailIdentity(req.Email)
+	if id.Identifier == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	// 由目录管理的邮箱不能在这里重置密码
+	if ldap.ManagesEmail(id.Identifier) {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasswordManaged)
+	}
+
+	// 邮箱已注册时才发送重置密码验证码
+	if err := s.sendPurposeCode(ctx, consts.CodePurposeResetPassword, id, email.SendPasswordResetCode); err != nil {
+		return nil, err
+	}
+
+	return &Practice.ForgotPasswordResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "如果该邮箱已注册，重置密码验证码已发送到您的邮箱，请查收",
+	}, nil
+}
+
+// ResetPassword 使用重置密码验证码设置新密码
+// 重置成功后吊销该用户的全部token和登录会话
+func (s *AuthServiceImpl) ResetPassword(ctx context.Context, req *Practice.ResetPasswordReq) (*Practice.ResetPasswordResp, error) {
+	// 与ForgotPassword使用相同的标识，验证码、冷却和冻结都按小写邮箱记录
+	id := emailIdentity(req.Email)
+	if id.Identifier == "" || req.VerifyCode == "" || req.NewPassword == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	// 校验密码策略，放在校验验证码之前，避免验证码被消耗
+	if err := checkPasswordPolicy(req.NewPassword, id.Identifier); err != nil {
+		return nil, err
+	}
+
+	// 校验验证码
+	if err := verifyPurposeCode(ctx, consts.CodePurposeResetPassword, id.Identifier, req.VerifyCode); err != nil {
+		return nil, err
+	}
+
+	// 查找用户
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.findUserByIdentity(mongoCtx, id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if foundUser == nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUserNotExist)
+	}
+
+	if err = checkPasswordManaged(foundUser); err != nil {
+		return nil, err
+	}
+
+	// 更新密码
+	if err = s.updatePassword(mongoCtx, foundUser, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	// 吊销此前签发的所有token，包括各个会话的refresh token
+	if err = util.RevokeUserTokensBefore(ctx, foundUser.ID.Hex(), time.Now()); err != nil {
+		fmt.Println("吊销用户token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	// 同步吊销所有会话记录
+	if err = s.revokeUserSessions(mongoCtx, foundUser.ID); err != nil {
+		return nil, err
+	}
+
+	return &Practice.ResetPasswordResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "密码已重置，请使用新密码重新登录",
+	}, nil
+}
+
+// ChangePassword 修改当前用户密码，需要校验当前密码
+// 当前密码错误计入登录失败次数；修改成功后吊销其他所有会话，保留当前会话
+func (s *AuthServiceImpl) ChangePassword(ctx context.Context, req *Practice.ChangePasswordReq, current *CurrentToken, clientIP string) (*Practice.ChangePasswordResp, error) {
+	// 验证当前用户是否已认证，服务账号没有密码
+	if current.UserID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+	if current.SubjectType != consts.SubjectTypeUser {
+		return nil, consts.NewAppErrorWithCode(consts.ErrForbidden)
+	}
+
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+	if req.CurrentPassword == req.NewPassword {
+		return nil, consts.NewAppError(consts.ErrParams, "新密码不能与当前密码相同")
+	}
+
+	// 查找用户
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.findUserByHex(mongoCtx, current.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkPasswordManaged(foundUser); err != nil {
+		return nil, err
+	}
+
+	// 校验密码策略
+	if err = checkPasswordPolicy(req.NewPassword, foundUser.LoginIdentifier()); err != nil {
+		return nil, err
+	}
+
+	// 与登录共用锁定规则，防止通过该接口暴力破解密码
+	if err = checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP); err != nil {
+		return nil, err
+	}
+
+	if !verifyPassword(ctx, foundUser, req.CurrentPassword, clientIP) {
+		return nil, consts.NewAppErrorWithCode(consts.ErrPasswordIncorrect)
+	}
+
+	// 更新密码
+	if err = s.updatePassword(mongoCtx, foundUser, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	// 吊销其他会话及其token，当前会话的token继续有效
+	if err = s.revokeOtherSessions(mongoCtx, foundUser.ID, current.SessionID); err != nil {
+		return nil, err
+	}
+
+	// 引入会话之前签发的token没有会话ID，不属于任何会话记录，按签发时间一并吊销
+	if err = util.RevokeUserTokensExcept(ctx, foundUser.ID.Hex(), time.Now(), current.SessionID); err != nil {
+		fmt.Println("吊销用户token失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return &Practice.ChangePasswordResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "密码已修改，其他设备上的登录已失效",
+	}, nil
+}
+
+// checkPasswordPolicy 校验新密码是否符合密码策略，所有设置密码的入口都必须调用
+// 不符合时返回ErrPasswordPolicy，并在响应的data.violations中列出违反的全部规则
+func checkPasswordPolicy(newPassword, userEmail string) error {
+	violations := password.Check(newPassword, userEmail)
+	if len(violations) == 0 {
+		return nil
+	}
+	return consts.NewAppErrorWithData(consts.ErrPasswordPolicy, map[string]interface{}{
+		"violations": violations,
+	})
+}
+
+// flagBreachedPassword 登录时检查密码是否已泄露（需开启FlagBreachedOnLogin），泄露则标记用户需要修改密码
+// 返回用户是否需要修改密码；检查失败时只记录日志，不影响登录
+func (s *AuthServiceImpl) flagBreachedPassword(ctx context.Context, foundUser *user.User, plainPassword string) bool {
+	if foundUser.PasswordResetRequired {
+		return true
+	}
+	// 目录用户的密码不能在这里修改，由目录自己的密码策略管理
+	if foundUser.CredentialSource == consts.CredentialSourceLDAP {
+		return false
+	}
+	if !config.GetConfig().PasswordPolicy.FlagBreachedOnLogin {
+		return false
+	}
+
+	breached, err := password.IsBreached(plainPassword)
+	if err != nil {
+		fmt.Println("检查泄露密码库失败:", err)
+		return false
+	}
+	if !breached {
+		return false
+	}
+
+	foundUser.PasswordResetRequired = true
+	if err = s.userDAO.Update(ctx, foundUser); err != nil {
+		fmt.Println("标记用户需要修改密码失败:", err)
+		// 非致命错误，继续流程
+	}
+	return true
+}
+
+// updatePassword 设置新密码：拒绝与最近使用过的密码相同，当前密码哈希移入历史记录
+func (s *AuthServiceImpl) updatePassword(ctx context.Context, foundUser *user.User, newPassword string) error {
+	historySize := config.GetConfig().PasswordPolicy.HistorySize
+	if historySize > 0 {
+		// 当前密码和历史密码都算作最近使用过的密码
+		recent := append([]string{foundUser.Password}, foundUser.PasswordHistory...)
+		if len(recent) > historySize {
+			recent = recent[:historySize]
+		}
+		if password.MatchesAny(newPassword, recent...) {
+			return consts.NewAppErrorWithData(consts.ErrPasswordPolicy, map[string]interface{}{
+				"violations": []password.Violation{{
+					Rule:    password.RuleReused,
+					Message: fmt.Sprintf("新密码不能与最近使用过的%d个密码相同", historySize),
+				}},
+			})
+		}
+	}
+
+	// 密码加密
+	hashedPassword, err := hashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	// 历史记录最多保留historySize-1个，加上当前密码共historySize个
+	history := append([]string{foundUser.Password}, foundUser.PasswordHistory...)
+	if historySize <= 1 {
+		history = nil
+	} else if len(history) > historySize-1 {
+		history = history[:historySize-1]
+	}
+
+	foundUser.Password = hashedPassword
+	foundUser.PasswordHistory = history
+	foundUser.PasswordResetRequired = false
+	if err = s.userDAO.Update(ctx, foundUser); err != nil {
+		fmt.Println("更新密码失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	return nil
+}
+
+// hashPassword 使用配置的算法计算密码哈希
+func hashPassword(plainPassword string) (string, error) {
+	hashed, err := password.Hash(plainPassword)
+	if err != nil {
+		fmt.Println("计算密码哈希失败:", err)
+		return "", consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+	return hashed, nil
+}
+
+// verifyPassword 校验用户密码并维护登录失败计数
+// 密码错误时同时增加邮箱和IP维度的失败计数，正确时重置
+func verifyPassword(ctx context.Context, foundUser *user.User, plainPassword string, clientIP string) bool {
+	matched, err := password.Verify(plainPassword, foundUser.Password)
+	if err != nil {
+		fmt.Println("校验密码哈希失败:", err)
+	}
+	if !matched {
+		util.HandleLoginFail(ctx, foundUser.LoginIdentifier(), clientIP)
+		return false
+	}
+
+	// 校验成功，重置失败计数
+	go func() {
+		util.ResetLoginFailEmailCount(context.Background(), foundUser.LoginIdentifier())
+		util.ResetLoginFailIPCount(context.Background(), clientIP)
+	}()
+	return true
+}
+
+// rehashPasswordIfNeeded 登录成功后，若存储的哈希使用了旧算法或旧参数，按当前配置重新计算并保存
+// 失败时只记录日志，不影响登录
+func (s *AuthServiceImpl) rehashPasswordIfNeeded(ctx context.Context, foundUser *user.User, plainPassword string) {
+	if !password.NeedsRehash(foundUser.Password) {
+		return
+	}
+
+	hashed, err := password.Hash(plainPassword)
+	if err != nil {
+		fmt.Println("重新计算密码哈希失败:", err)
+		return
+	}
+
+	foundUser.Password = hashed
+	if err = s.userDAO.Update(ctx, foundUser); err != nil {
+		fmt.Println("保存重新计算的密码哈希失败:", err)
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/password"
+	"auth/biz/infrastructure/util"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// mailCodePattern 邮件正文中单独一行的6位验证码
+var mailCodePattern = regexp.MustCompile(`\s(\d{6})\s`)
+
+// lastMailedCode 取出最近一封发给to的邮件中的验证码
+func lastMailedCode(t *testing.T, to string) string {
+	t.Helper()
+	msg, ok := mailServer.LastMessageTo(to)
+	if !ok {
+		t.Fatalf("没有发给%s的邮件", to)
+	}
+	m := mailCodePattern.FindStringSubmatch(msg.Data)
+	if m == nil {
+		t.Fatalf("邮件中没有验证码: %s", msg.Data)
+	}
+	return m[1]
+}
+
+func TestResetPasswordNormalizesEmail(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	existing := &user.User{Email: "reset@example.com"}
+	newPasskeyUser(t, s, existing)
+
+	// 申请和重置时输入的大小写、空白不同，都按小写邮箱处理
+	if _, err := s.ForgotPassword(ctx, &Practice.ForgotPasswordReq{Email: " Reset@Example.COM "}); err != nil {
+		t.Fatalf("申请重置密码失败: %v", err)
+	}
+	code := lastMailedCode(t, "reset@example.com")
+
+	_, err := s.ResetPassword(ctx, &Practice.ResetPasswordReq{
+		Email:       "RESET@example.com ",
+		VerifyCode:  code,
+		NewPassword: "Fresh-Passw0rd-2024",
+	})
+	if err != nil {
+		t.Fatalf("重置密码失败: %v", err)
+	}
+
+	updated, _ := s.userDAO.FindByID(ctx, existing.ID)
+	if matched, _ := password.Verify("Fresh-Passw0rd-2024", updated.Password); !matched {
+		t.Fatal("重置后新密码无效")
+	}
+
+	// 验证码只能使用一次
+	_, err = s.ResetPassword(ctx, &Practice.ResetPasswordReq{
+		Email:       "reset@example.com",
+		VerifyCode:  code,
+		NewPassword: "Another-Passw0rd-2024",
+	})
+	assertAppError(t, err, consts.ErrVerifyCodeExpired)
+}
+
+func TestForgotPasswordRejectsDirectoryEmail(t *testing.T) {
+	s := newTestService()
+
+	// 大小写不同的目录域名同样由目录管理密码
+	_, err := s.ForgotPassword(context.Background(), &Practice.ForgotPasswordReq{Email: " Staff@CORP.Example.com "})
+	assertAppError(t, err, consts.ErrPasswordManaged)
+}
+
+func TestChangePasswordRevokesTokensWithoutSession(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	existing := &user.User{Email: "change@example.com"}
+	newPasskeyUser(t, s, existing)
+
+	issue := func(sessionID string) *tokenPair {
+		tokens, err := s.issueTokens(ctx, &tokenGrant{UserID: existing.ID.Hex(), Email: existing.Email, SessionID: sessionID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tokens
+	}
+	currentSession, _ := s.createSession(ctx, existing.ID, "127.0.0.1", "test")
+	otherSession, _ := s.createSession(ctx, existing.ID, "127.0.0.1", "test")
+	current := issue(currentSession)
+	other := issue(otherSession)
+	// 引入会话之前签发的token没有会话ID
+	legacy := issue("")
+
+	time.Sleep(2 * time.Millisecond)
+	_, err := s.ChangePassword(ctx, &Practice.ChangePasswordReq{
+		CurrentPassword: "current-password",
+		NewPassword:     "Rotated-Passw0rd-2024",
+	}, &CurrentToken{UserID: existing.ID.Hex(), SubjectType: consts.SubjectTypeUser, SessionID: currentSession}, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("修改密码失败: %v", err)
+	}
+
+	// 其他会话和没有会话ID的token都失效
+	for name, tokens := range map[string]*tokenPair{"其他会话": other, "无会话ID": legacy} {
+		if _, err = jwt.ParseToken(tokens.AccessToken); err == nil {
+			t.Errorf("%s的access token应失效", name)
+		}
+		_, _, err = s.rotateRefreshToken(ctx, tokens.RefreshToken, "")
+		assertAppError(t, err, consts.ErrRefreshInvalid)
+	}
+
+	// 当前会话继续有效
+	if _, err = jwt.ParseToken(current.AccessToken); err != nil {
+		t.Fatalf("当前会话的access token应有效: %v", err)
+	}
+	if _, _, err = s.rotateRefreshToken(ctx, current.RefreshToken, ""); err != nil {
+		t.Fatalf("当前会话应能刷新: %v", err)
+	}
+
+	// 之后整体吊销时当前会话同样失效
+	time.Sleep(2 * time.Millisecond)
+	if err = util.RevokeUserTokensBefore(ctx, existing.ID.Hex(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.ParseToken(current.AccessToken); err == nil {
+		t.Fatal("整体吊销后当前会话的access token应失效")
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/util"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRotateRefreshTokenChecksClientBeforeConsuming(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    "507f1f77bcf86cd799439011",
+		Email:     "refresh@example.com",
+		SessionID: "507f1f77bcf86cd799439012",
+		ClientID:  "client-a",
+		Scope:     consts.ScopeAuthRead,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 其他客户端和第一方刷新接口都不能使用，也不能让token失效
+	for _, clientID := range []string{"client-b", ""} {
+		_, _, err = s.rotateRefreshToken(ctx, tokens.RefreshToken, clientID)
+		assertAppError(t, err, consts.ErrRefreshInvalid)
+	}
+
+	rotated, data, err := s.rotateRefreshToken(ctx, tokens.RefreshToken, "client-a")
+	if err != nil {
+		t.Fatalf("签发该token的客户端应能刷新: %v", err)
+	}
+	if data.ClientID != "client-a" || data.Scope != consts.ScopeAuthRead || rotated.RefreshToken == tokens.RefreshToken {
+		t.Fatalf("data = %+v", data)
+	}
+
+	// 已轮换的token被重放时吊销整个token族
+	_, _, err = s.rotateRefreshToken(ctx, tokens.RefreshToken, "client-a")
+	assertAppError(t, err, consts.ErrRefreshReused)
+	_, _, err = s.rotateRefreshToken(ctx, rotated.RefreshToken, "client-a")
+	assertAppError(t, err, consts.ErrRefreshInvalid)
+}
+
+func TestRevokeUserTokensSameSecond(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	grant := &tokenGrant{
+		UserID:    "507f1f77bcf86cd799439021",
+		Email:     "revoke@example.com",
+		SessionID: "507f1f77bcf86cd799439022",
+	}
+	before, err := s.issueTokens(ctx, grant)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 与签发在同一秒内吊销，此前签发的token同样失效
+	time.Sleep(2 * time.Millisecond)
+	if err = util.RevokeUserTokensBefore(ctx, grant.UserID, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.ParseToken(before.AccessToken); err == nil {
+		t.Fatal("吊销之前签发的access token应失效")
+	}
+	_, _, err = s.rotateRefreshToken(ctx, before.RefreshToken, "")
+	assertAppError(t, err, consts.ErrRefreshInvalid)
+
+	// 吊销之后立即重新登录签发的token不受影响
+	time.Sleep(2 * time.Millisecond)
+	after, err := s.issueTokens(ctx, grant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = jwt.ParseToken(after.AccessToken); err != nil {
+		t.Fatalf("吊销之后签发的access token应有效: %v", err)
+	}
+	if _, _, err = s.rotateRefreshToken(ctx, after.RefreshToken, ""); err != nil {
+		t.Fatalf("吊销之后签发的refresh token应有效: %v", err)
+	}
+}
+
+func TestRevokeTimeInSeconds(t *testing.T) {
+	ctx := context.Background()
+	userID := "507f1f77bcf86cd799439031"
+	now := time.Now()
+
+	// 升级前以秒记录的吊销时间，该秒内签发的token都视为已吊销
+	key := util.GetTokenRevokeBeforeKey(userID)
+	if err := util.SetWithExpire(ctx, key, strconv.FormatInt(now.Unix(), 10), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := util.IsTokenRevoked(ctx, "", userID, "", now.Truncate(time.Second).Add(999*time.Millisecond).UnixMilli())
+	if err != nil || !revoked {
+		t.Fatalf("revoked = %v, %v, want true", revoked, err)
+	}
+	revoked, err = util.IsTokenRevoked(ctx, "", userID, "", now.Truncate(time.Second).Add(time.Second).UnixMilli())
+	if err != nil || revoked {
+		t.Fatalf("revoked = %v, %v, want false", revoked, err)
+	}
+
+	// 升级前签发的token只有秒级签发时间，与吊销同一秒签发时视为已吊销
+	if !util.IssuedBeforeRevoke(util.TokenIssuedAtMilli(now.Unix(), 0), now.UnixMilli()) {
+		t.Fatal("同一秒签发的旧token应视为已吊销")
+	}
+}
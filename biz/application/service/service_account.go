@@ -0,0 +1,248 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/jwt"
+	"auth/biz/infrastructure/mapper/serviceaccount"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// CreateServiceAccount 创建服务账号（管理员功能）
+// client_secret只在创建时返回一次，库中只保存其bcrypt摘要
+func (s *AuthServiceImpl) CreateServiceAccount(ctx context.Context, req *Practice.CreateServiceAccountReq, current *CurrentToken) (*Practice.CreateServiceAccountResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	creatorID, err := s.requireAdmin(mongoCtx, current.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验参数
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+	for _, scope := range req.Scopes {
+		if scope == "" || strings.ContainsAny(scope, " \t\r\n") {
+			return nil, consts.NewAppError(consts.ErrParams, "授权范围无效: "+scope)
+		}
+	}
+
+	// 生成client_id
+	clientID, err := util.GenerateRandomHex(consts.ClientIDBytes)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	clientSecret, secretHash, err := generateClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	account := &serviceaccount.ServiceAccount{
+		ClientID:   clientID,
+		Name:       name,
+		SecretHash: secretHash,
+		Scopes:     req.Scopes,
+		CreatorID:  creatorID,
+	}
+	if err = s.serviceAccountDAO.Create(mongoCtx, account); err != nil {
+		fmt.Println("创建服务账号失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	return &Practice.CreateServiceAccountResp{
+		Code:         consts.Success,
+		Msg:          "创建服务账号成功",
+		Account:      toServiceAccountInfo(account),
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+// ListServiceAccounts 获取服务账号列表（管理员功能）
+func (s *AuthServiceImpl) ListServiceAccounts(ctx context.Context, req *Practice.ListServiceAccountsReq, current *CurrentToken) (*Practice.ListServiceAccountsResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if _, err := s.requireAdmin(mongoCtx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.serviceAccountDAO.FindAll(mongoCtx)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 转换为响应结构
+	infos := make([]*Practice.ServiceAccountInfo, 0, len(accounts))
+	for _, item := range accounts {
+		infos = append(infos, toServiceAccountInfo(item))
+	}
+
+	return &Practice.ListServiceAccountsResp{
+		Code:     consts.Success,
+		Msg:      "获取服务账号列表成功",
+		Accounts: infos,
+	}, nil
+}
+
+// RotateServiceAccountSecret 轮换服务账号密钥（管理员功能）
+// 旧密钥立即失效，已签发的access token在过期前仍然有效
+func (s *AuthServiceImpl) RotateServiceAccountSecret(ctx context.Context, req *Practice.RotateServiceAccountSecretReq, current *CurrentToken) (*Practice.RotateServiceAccountSecretResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if _, err := s.requireAdmin(mongoCtx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	clientSecret, secretHash, err := generateClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.serviceAccountDAO.UpdateSecret(mongoCtx, req.ClientId, secretHash)
+	if err != nil {
+		fmt.Println("轮换服务账号密钥失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if !found {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	return &Practice.RotateServiceAccountSecretResp{
+		Code:         consts.Success,
+		Msg:          "密钥已轮换",
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+// SetServiceAccountStatus 启用或停用服务账号（管理员功能）
+// 停用时同时吊销该服务账号已签发的全部token
+func (s *AuthServiceImpl) SetServiceAccountStatus(ctx context.Context, req *Practice.SetServiceAccountStatusReq, current *CurrentToken) (*Practice.SetServiceAccountStatusResp, error) {
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	if _, err := s.requireAdmin(mongoCtx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	account, err := s.serviceAccountDAO.FindByClientID(mongoCtx, req.ClientId)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if account == nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	if _, err = s.serviceAccountDAO.SetDisabled(mongoCtx, req.ClientId, req.Disabled); err != nil {
+		fmt.Println("更新服务账号状态失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	message := "服务账号已启用"
+	if req.Disabled {
+		// 吊销已签发的token
+		if err = util.RevokeUserTokensBefore(ctx, account.ID.Hex(), time.Now()); err != nil {
+			fmt.Println("吊销服务账号token失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+		}
+		message = "服务账号已停用"
+	}
+
+	return &Practice.SetServiceAccountStatusResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: message,
+	}, nil
+}
+
+// clientCredentialsGrant 服务账号使用client_credentials换取access token（RFC 6749 4.4）
+// 不签发refresh token，也不创建登录会话；未指定scope时授予服务账号的全部授权范围
+func (s *AuthServiceImpl) clientCredentialsGrant(ctx context.Context, req *TokenReq) (*TokenResp, error) {
+	if req.ClientID == "" {
+		return nil, newOAuthError(OAuthErrInvalidClient, "缺少client_id")
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	account, err := s.serviceAccountDAO.FindByClientID(mongoCtx, req.ClientID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 服务账号不存在、已停用或密钥错误统一返回invalid_client
+	if account == nil || account.Disabled || req.ClientSecret == "" {
+		return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(account.SecretHash), []byte(req.ClientSecret)); err != nil {
+		return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+	}
+
+	// 校验授权范围
+	scopes := strings.Fields(req.Scope)
+	if len(scopes) == 0 {
+		scopes = account.Scopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(account.Scopes, scope) {
+			return nil, newOAuthError(OAuthErrInvalidScope, "不允许的授权范围: "+scope)
+		}
+	}
+	scope := strings.Join(scopes, " ")
+
+	accessToken, accessExpire, err := jwt.GenerateToken(jwt.Claims{
+		UserId:   account.ID.Hex(),
+		SubType:  consts.SubjectTypeService,
+		ClientId: account.ClientID,
+		Scope:    scope,
+	})
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrTokenGenerating)
+	}
+
+	return &TokenResp{
+		AccessToken: accessToken,
+		TokenType:   consts.TokenType,
+		ExpiresIn:   accessExpire - time.Now().Unix(),
+		Scope:       scope,
+	}, nil
+}
+
+// generateClientSecret 生成client_secret及其bcrypt摘要
+func generateClientSecret() (string, string, error) {
+	clientSecret, err := util.GenerateRandomHex(consts.ClientSecretBytes)
+	if err != nil {
+		return "", "", consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	hashedSecret, err := bcrypt.GenerateFromPassword([]byte(clientSecret), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+	return clientSecret, string(hashedSecret), nil
+}
+
+// toServiceAccountInfo 转换为响应结构
+func toServiceAccountInfo(item *serviceaccount.ServiceAccount) *Practice.ServiceAccountInfo {
+	return &Practice.ServiceAccountInfo{
+		ClientId:         item.ClientID,
+		Name:             item.Name,
+		Scopes:           item.Scopes,
+		Disabled:         item.Disabled,
+		CreateTime:       item.CreateTime.Unix(),
+		SecretRotateTime: item.SecretRotateTime.Unix(),
+	}
+}
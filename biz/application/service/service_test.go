@@ -0,0 +1,103 @@
+package service
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/email/mocksmtp"
+	"auth/biz/infrastructure/ldap/mockdirectory"
+	"auth/biz/infrastructure/social/mockprovider"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// 测试共用的模拟服务
+var (
+	redisServer     *miniredis.Miniredis
+	socialServer    *mockprovider.Server
+	directoryServer *mockdirectory.Server
+	mailServer      *mocksmtp.Server
+)
+
+const (
+	// testSocialProvider 指向模拟服务商的第三方登录服务商名称
+	testSocialProvider = "mock"
+	// testDirectoryDomain 由模拟目录管理的邮箱域名
+	testDirectoryDomain = "corp.example.com"
+	testDirectoryBaseDN = "ou=people,dc=corp,dc=example,dc=com"
+	testAdminGroup      = "cn=admins,ou=groups,dc=corp,dc=example,dc=com"
+	// testEncryptionKey 只用于测试的MFA加密密钥
+	testEncryptionKey = "BMOB0/klIVHAK+ZOVfJ3joYnXsi1nTfefzXm8kDH3Vg="
+)
+
+// TestMain 启动内存Redis、模拟第三方登录服务商、模拟LDAP目录和模拟SMTP服务器，测试不访问外部服务
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+	var err error
+	redisServer, err = miniredis.Run()
+	if err != nil {
+		fmt.Println("启动内存Redis失败:", err)
+		return 1
+	}
+	defer redisServer.Close()
+
+	socialServer, err = mockprovider.NewServer()
+	if err != nil {
+		fmt.Println("启动模拟服务商失败:", err)
+		return 1
+	}
+	defer socialServer.Close()
+
+	directoryServer, err = mockdirectory.NewServer()
+	if err != nil {
+		fmt.Println("启动模拟LDAP目录失败:", err)
+		return 1
+	}
+	defer directoryServer.Close()
+
+	mailServer, err = mocksmtp.NewServer()
+	if err != nil {
+		fmt.Println("启动模拟SMTP服务器失败:", err)
+		return 1
+	}
+	defer mailServer.Close()
+
+	port, _ := strconv.Atoi(redisServer.Port())
+	conf := config.GetConfig()
+	conf.Redis = config.RedisConfig{Host: redisServer.Host(), Port: port}
+	conf.Email = mailServer.EmailConfig()
+	conf.SocialLogin.Providers = []config.SocialProviderConfig{
+		socialServer.ProviderConfig(testSocialProvider, "http://localhost:8888/api/auth/oauth/mock/callback"),
+	}
+	conf.LDAP = directoryServer.LDAPConfig("", "", testDirectoryBaseDN, testDirectoryDomain)
+	conf.LDAP.GroupRoles = map[string]string{testAdminGroup: consts.RoleAdmin}
+	conf.MFA.EncryptionKey = testEncryptionKey
+	return m.Run()
+}
+
+// newTestService 使用内存DAO创建服务，每个测试之间数据隔离
+func newTestService() *AuthServiceImpl {
+	redisServer.FlushAll()
+	return &AuthServiceImpl{
+		userDAO:     newMemUserDAO(),
+		sessionDAO:  newMemSessionDAO(),
+		passkeyDAO:  &memPasskeyDAO{},
+		identityDAO: &memIdentityDAO{},
+	}
+}
+
+// assertAppError 检查返回的业务错误码
+func assertAppError(t *testing.T, err error, code int) {
+	t.Helper()
+	var appErr *consts.AppError
+	if !errors.As(err, &appErr) || appErr.Code != code {
+		t.Fatalf("err = %v, want error code %d", err, code)
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/session"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/util"
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ListSessions 获取当前用户的登录会话列表
+func (s *AuthServiceImpl) ListSessions(ctx context.Context, req *Practice.ListSessionsReq, current *CurrentToken) (*Practice.ListSessionsResp, error) {
+	// 验证当前用户是否已认证
+	if current.UserID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+
+	userID, err := primitive.ObjectIDFromHex(current.UserID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	sessions, err := s.sessionDAO.FindActiveByUserID(mongoCtx, userID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 转换为响应结构
+	infos := make([]*Practice.SessionInfo, 0, len(sessions))
+	for _, item := range sessions {
+		infos = append(infos, &Practice.SessionInfo{
+			Id:           item.ID.Hex(),
+			UserAgent:    item.UserAgent,
+			Ip:           item.IP,
+			CreateTime:   item.CreateTime.Unix(),
+			LastSeenTime: item.LastSeenTime.Unix(),
+			Current:      item.ID.Hex() == current.SessionID,
+		})
+	}
+
+	return &Practice.ListSessionsResp{
+		Code:     consts.Success,
+		Msg:      "获取会话列表成功",
+		Sessions: infos,
+	}, nil
+}
+
+// RevokeSession 吊销当前用户的指定登录会话
+func (s *AuthServiceImpl) RevokeSession(ctx context.Context, req *Practice.RevokeSessionReq, current *CurrentToken) (*Practice.RevokeSessionResp, error) {
+	// 验证当前用户是否已认证
+	if current.UserID == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrUnauthorized)
+	}
+
+	sessionID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	found, err := s.sessionDAO.FindByID(mongoCtx, sessionID)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 会话不存在或不属于当前用户时统一返回不存在，避免泄露其他用户的会话
+	if found == nil || found.UserID.Hex() != current.UserID {
+		return nil, consts.NewAppErrorWithCode(consts.ErrNotFound)
+	}
+
+	if err = s.revokeSession(mongoCtx, found.ID); err != nil {
+		return nil, err
+	}
+
+	return &Practice.RevokeSessionResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "该设备已退出登录",
+	}, nil
+}
+
+// kickSession 管理员踢出用户的指定会话
+func (s *AuthServiceImpl) kickSession(ctx context.Context, targetUser *user.User, id string) (*Practice.KickUserResp, error) {
+	sessionID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	found, err := s.sessionDAO.FindByID(ctx, sessionID)
+	if err != nil {
+		return &Practice.KickUserResp{
+			Code:    consts.ErrMongo,
+			Msg:     consts.ErrMsg[consts.ErrMongo],
+			Message: "查询会话失败",
+		}, nil
+	}
+
+	if found == nil || found.UserID != targetUser.ID {
+		return &Practice.KickUserResp{
+			Code:    consts.ErrNotFound,
+			Msg:     consts.ErrMsg[consts.ErrNotFound],
+			Message: "会话不存在",
+		}, nil
+	}
+
+	if err = s.revokeSession(ctx, found.ID); err != nil {
+		return nil, err
+	}
+
+	return &Practice.KickUserResp{
+		Code:    consts.Success,
+		Msg:     "操作成功",
+		Message: "用户的该会话已被踢出，对应设备需要重新登录",
+	}, nil
+}
+
+// createSession 为成功登录的用户创建会话，返回会话ID
+func (s *AuthServiceImpl) createSession(ctx context.Context, userID primitive.ObjectID, clientIP, userAgent string) (string, error) {
+	expire := time.Duration(config.GetConfig().JWT.RefreshExpireTime) * time.Second
+	newSession := &session.Session{
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IP:         clientIP,
+		ExpireTime: time.Now().Add(expire),
+	}
+
+	if err := s.sessionDAO.Create(ctx, newSession); err != nil {
+		fmt.Println("创建登录会话失败:", err)
+		return "", consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	return newSession.ID.Hex(), nil
+}
+
+// revokeSession 吊销会话：更新会话记录，标记会话已吊销并吊销其refresh token族
+func (s *AuthServiceImpl) revokeSession(ctx context.Context, sessionID primitive.ObjectID) error {
+	if err := s.sessionDAO.Revoke(ctx, sessionID); err != nil {
+		fmt.Println("吊销会话失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	return markSessionRevoked(ctx, sessionID.Hex())
+}
+
+// revokeUserSessions 吊销用户的所有会话
+func (s *AuthServiceImpl) revokeUserSessions(ctx context.Context, userID primitive.ObjectID) error {
+	ids, err := s.sessionDAO.RevokeByUserID(ctx, userID)
+	if err != nil {
+		fmt.Println("吊销用户会话失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	for _, id := range ids {
+		if err = markSessionRevoked(ctx, id.Hex()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeOtherSessions 吊销用户除指定会话外的所有会话
+func (s *AuthServiceImpl) revokeOtherSessions(ctx context.Context, userID primitive.ObjectID, keepSessionID string) error {
+	sessions, err := s.sessionDAO.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	for _, item := range sessions {
+		if item.ID.Hex() == keepSessionID {
+			continue
+		}
+		if err = s.revokeSession(ctx, item.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markSessionRevoked 在Redis中标记会话及其refresh token族已吊销
+func markSessionRevoked(ctx context.Context, sessionID string) error {
+	if err := util.MarkSessionRevoked(ctx, sessionID); err != nil {
+		fmt.Println("标记会话吊销失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	if err := util.RevokeRefreshFamily(ctx, sessionID); err != nil {
+		fmt.Println("吊销refresh token族失败:", err)
+		return consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/ldap"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/social"
+	"auth/biz/infrastructure/util"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// StartSocialLoginReq 开始第三方登录请求
+type StartSocialLoginReq struct {
+	Provider string `query:"-"`     // 服务商名称，来自路径参数
+	Scope    string `query:"scope"` // 登录成功后申请的授权范围，为空时授予全部第一方授权范围
+}
+
+// StartSocialLoginResp 开始第三方登录响应，由控制器设置state cookie并重定向到AuthURL
+type StartSocialLoginResp struct {
+	State   string
+	AuthURL string
+}
+
+// FinishSocialLoginReq 第三方登录回调请求，服务商重定向回来时在查询参数中携带
+// 回调地址指向前端页面时，由前端原样提交这些参数
+type FinishSocialLoginReq struct {
+	Provider         string `query:"-" form:"-" json:"-"` // 服务商名称，来自路径参数
+	Code             string `query:"code" form:"code" json:"code"`
+	State            string `query:"state" form:"state" json:"state"`
+	Error            string `query:"error" form:"error" json:"error"` // 用户拒绝授权等情况下服务商返回的错误码
+	ErrorDescription string `query:"error_description" form:"error_description" json:"errorDescription"`
+}
+
+// socialLoginState 保存在Redis中的第三方登录数据
+type socialLoginState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"codeVerifier,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+// StartSocialLogin 开始第三方登录，生成state和PKCE参数，返回服务商授权页地址
+func (s *AuthServiceImpl) StartSocialLogin(ctx context.Context, req *StartSocialLoginReq) (*StartSocialLoginResp, error) {
+	provider, err := social.GetProvider(req.Provider)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialProvider)
+	}
+
+	scope, err := resolveLoginScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := util.GenerateSocialState()
+	if err != nil {
+		fmt.Println("生成第三方登录state失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	data := socialLoginState{Provider: provider.Name(), Scope: scope}
+	codeChallenge := ""
+	if provider.UsePKCE() {
+		data.CodeVerifier, err = social.GenerateCodeVerifier()
+		if err != nil {
+			fmt.Println("生成PKCE code_verifier失败:", err)
+			return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+		}
+		codeChallenge = social.CodeChallengeS256(data.CodeVerifier)
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, codeChallenge)
+	if err != nil {
+		fmt.Println("生成第三方登录授权地址失败:", provider.Name(), err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialFailed)
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSystem)
+	}
+
+	if err = util.SaveSocialState(ctx, state, string(encoded)); err != nil {
+		fmt.Println("保存第三方登录state失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	return &StartSocialLoginResp{
+		State:   state,
+		AuthURL: authURL,
+	}, nil
+}
+
+// FinishSocialLogin 处理第三方登录回调：校验state，用授权码换取令牌并获取第三方账号信息
+// 已关联的第三方账号直接登录；未关联时按服务商确认过的邮箱关联已有用户，没有则创建用户
+// cookieState为发起登录时设置在浏览器中的state，必须与回调参数一致
+func (s *AuthServiceImpl) FinishSocialLogin(ctx context.Context, req *FinishSocialLoginReq, cookieState string, clientIP string, userAgent string) (*Practice.LoginResp, error) {
+	if req.State == "" || subtle.ConstantTimeCompare([]byte(req.State), []byte(cookieState)) != 1 {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialState)
+	}
+
+	// 检查IP是否被锁定，此时还不知道是哪个账号
+	isIPLocked, err := util.IsLoginLockedByIP(ctx, clientIP)
+	if err != nil {
+		fmt.Println("检查IP锁定状态失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	if isIPLocked {
+		return nil, consts.NewAppErrorWithCode(consts.ErrLoginLocked)
+	}
+
+	// 原子取出登录数据，state只能使用一次
+	encoded, err := util.ConsumeSocialState(ctx, req.State)
+	if err != nil {
+		fmt.Println("获取第三方登录state失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrRedis)
+	}
+
+	var data socialLoginState
+	if encoded == "" || json.Unmarshal([]byte(encoded), &data) != nil || data.Provider != req.Provider {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialState)
+	}
+
+	// 用户在服务商处拒绝授权或服务商返回错误
+	if req.Error != "" {
+		fmt.Println("第三方登录服务商返回错误:", data.Provider, req.Error, req.ErrorDescription)
+		if req.Error == OAuthErrAccessDenied {
+			return nil, consts.NewAppError(consts.ErrSocialFailed, "已取消第三方登录")
+		}
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialFailed)
+	}
+
+	if req.Code == "" {
+		return nil, consts.NewAppErrorWithCode(consts.ErrParams)
+	}
+
+	provider, err := social.GetProvider(data.Provider)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialProvider)
+	}
+
+	accessToken, err := provider.Exchange(ctx, req.Code, data.CodeVerifier)
+	if err != nil {
+		fmt.Println("第三方登录换取令牌失败:", provider.Name(), err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialFailed)
+	}
+
+	profile, err := provider.FetchProfile(ctx, accessToken)
+	if err != nil {
+		fmt.Println("获取第三方账号信息失败:", provider.Name(), err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialFailed)
+	}
+
+	mongoCtx, cancel := util.CreateContext()
+	defer cancel()
+
+	foundUser, err := s.resolveSocialUser(mongoCtx, provider, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查账号和IP是否被锁定
+	if err = checkLoginLocked(ctx, foundUser.LoginIdentifier(), clientIP); err != nil {
+		return nil, err
+	}
+
+	// 第三方登录只证明持有第三方账号，已开启两步验证时只返回mfa token
+	if foundUser.TOTPEnabled {
+		challenge, err := s.startMFAChallenge(ctx, foundUser, data.Scope)
+		if err != nil {
+			return nil, err
+		}
+		return &Practice.LoginResp{
+			Scope:                 data.Scope,
+			PasswordResetRequired: foundUser.PasswordResetRequired,
+			MfaRequired:           true,
+			MfaToken:              challenge.Token,
+			MfaExpire:             challenge.Expire,
+		}, nil
+	}
+
+	// 创建登录会话
+	sessionID, err := s.createSession(mongoCtx, foundUser.ID, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 签发令牌
+	tokens, err := s.issueTokens(ctx, &tokenGrant{
+		UserID:    foundUser.ID.Hex(),
+		Email:     foundUser.Email,
+		SessionID: sessionID,
+		Scope:     data.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回成功响应
+	return &Practice.LoginResp{
+		AccessToken:           tokens.AccessToken,
+		AccessExpire:          tokens.AccessExpire,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshExpire:         tokens.RefreshExpire,
+		Scope:                 data.Scope,
+		PasswordResetRequired: foundUser.PasswordResetRequired,
+	}, nil
+}
+
+// resolveSocialUser 查找第三方账号关联的用户，未关联时按已验证的邮箱关联或创建用户
+func (s *AuthServiceImpl) resolveSocialUser(ctx context.Context, provider *social.Provider, profile *social.Profile) (*user.User, error) {
+	linked, err := s.identityDAO.FindByIdentifier(ctx, provider.IdentityType(), profile.Subject)
+	if err != nil {
+		fmt.Println("查找第三方账号登录标识失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	if linked != nil {
+		foundUser, err := s.userDAO.FindByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+		}
+		if foundUser == nil {
+			fmt.Println("第三方账号关联的用户不存在:", provider.Name(), profile.Subject)
+			return nil, consts.NewAppErrorWithCode(consts.ErrUserNotExist)
+		}
+		// 关联第三方账号后才改由目录管理的用户
+		if err = checkDirectoryLogin(foundUser); err != nil {
+			return nil, err
+		}
+		return foundUser, nil
+	}
+
+	// 未关联时只能按服务商确认过的邮箱关联，未验证的邮箱可能属于其他人
+	if profile.Email == "" || !profile.EmailVerified {
+		return nil, consts.NewAppErrorWithCode(consts.ErrSocialEmail)
+	}
+
+	// 目录管理的域名的邮箱只能使用目录密码登录，不能关联第三方账号，也不能创建本地用户
+	if ldap.ManagesEmail(profile.Email) {
+		return nil, consts.NewAppErrorWithCode(consts.ErrDirectoryLogin)
+	}
+
+	foundUser, err := s.findOrCreateSocialUser(ctx, emailIdentity(profile.Email))
+	if err != nil {
+		return nil, err
+	}
+	if err = checkDirectoryLogin(foundUser); err != nil {
+		return nil, err
+	}
+
+	// 关联第三方账号，同一第三方账号并发回调时由唯一索引保证只关联一次
+	err = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID:     foundUser.ID,
+		Type:       provider.IdentityType(),
+		Identifier: profile.Subject,
+		Verified:   true,
+	})
+	if errors.Is(err, identity.ErrDuplicate) {
+		linked, err = s.identityDAO.FindByIdentifier(ctx, provider.IdentityType(), profile.Subject)
+		if err != nil || linked == nil || linked.UserID != foundUser.ID {
+			return nil, consts.NewAppErrorWithCode(consts.ErrSocialFailed)
+		}
+		return foundUser, nil
+	}
+	if err != nil {
+		fmt.Println("关联第三方账号失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	return foundUser, nil
+}
+
+// findOrCreateSocialUser 按邮箱查找用户，不存在时创建没有密码的用户
+// 没有密码的用户只能通过第三方登录、验证码登录或登录链接登录，可以通过忘记密码设置密码
+func (s *AuthServiceImpl) findOrCreateSocialUser(ctx context.Context, id *loginIdentity) (*user.User, error) {
+	foundUser, err := s.findUserByIdentity(ctx, id)
+	if err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	if foundUser != nil {
+		return foundUser, nil
+	}
+
+	newUser := &user.User{Email: id.Identifier}
+	if err = s.userDAO.Create(ctx, newUser); err != nil {
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+
+	// 写入邮箱登录标识，同一邮箱并发注册时由唯一索引保证只有一个成功
+	err = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID:     newUser.ID,
+		Type:       id.Type,
+		Identifier: id.Identifier,
+		Verified:   true,
+	})
+	if err != nil {
+		if deleteErr := s.userDAO.Delete(ctx, newUser.ID); deleteErr != nil {
+			fmt.Println("删除重复创建的用户失败:", deleteErr)
+		}
+		if errors.Is(err, identity.ErrDuplicate) {
+			return nil, consts.NewAppErrorWithCode(consts.ErrSocialFailed)
+		}
+		fmt.Println("创建登录标识失败:", err)
+		return nil, consts.NewAppErrorWithCode(consts.ErrMongo)
+	}
+	return newUser, nil
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"auth/biz/application/dto/Auth/Practice"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/identity"
+	"auth/biz/infrastructure/mapper/user"
+	"auth/biz/infrastructure/social/mockprovider"
+	"auth/biz/infrastructure/util"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// startSocialLogin 开始第三方登录，在模拟服务商处以loginHint对应的账号同意授权，返回回调参数
+func startSocialLogin(t *testing.T, s *AuthServiceImpl, loginHint string) (*StartSocialLoginResp, *FinishSocialLoginReq) {
+	t.Helper()
+	started, err := s.StartSocialLogin(context.Background(), &StartSocialLoginReq{Provider: testSocialProvider})
+	if err != nil {
+		t.Fatalf("开始第三方登录失败: %v", err)
+	}
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(started.AuthURL + "&login_hint=" + url.QueryEscape(loginHint))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("授权端点没有重定向回回调地址: %d %v", resp.StatusCode, err)
+	}
+	query := location.Query()
+	return started, &FinishSocialLoginReq{
+		Provider: testSocialProvider,
+		Code:     query.Get("code"),
+		State:    query.Get("state"),
+		Error:    query.Get("error"),
+	}
+}
+
+// finishSocialLogin 使用发起登录时的state cookie完成回调
+func finishSocialLogin(s *AuthServiceImpl, started *StartSocialLoginResp, req *FinishSocialLoginReq) (*Practice.LoginResp, error) {
+	return s.FinishSocialLogin(context.Background(), req, started.State, "127.0.0.1", "test")
+}
+
+func TestSocialLoginCreatesUser(t *testing.T) {
+	s := newTestService()
+	socialServer.AddUser(mockprovider.User{Subject: "new-1", Email: "new@example.com", EmailVerified: true})
+
+	started, callback := startSocialLogin(t, s, "new-1")
+	resp, err := finishSocialLogin(s, started, callback)
+	if err != nil {
+		t.Fatalf("第三方登录失败: %v", err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" {
+		t.Fatal("应签发令牌")
+	}
+
+	created, _ := s.findUserByIdentity(context.Background(), emailIdentity("new@example.com"))
+	if created == nil || created.Password != "" {
+		t.Fatal("应以服务商确认的邮箱创建没有密码的用户")
+	}
+	linked, _ := s.identityDAO.FindByIdentifier(context.Background(), consts.IdentityTypeSocialPrefix+testSocialProvider, "new-1")
+	if linked == nil || linked.UserID != created.ID {
+		t.Fatal("应关联第三方账号")
+	}
+
+	// 再次登录时按已关联的第三方账号找到同一用户
+	started, callback = startSocialLogin(t, s, "new-1")
+	if _, err = finishSocialLogin(s, started, callback); err != nil {
+		t.Fatalf("再次登录失败: %v", err)
+	}
+	if len(s.userDAO.(*memUserDAO).users) != 1 {
+		t.Fatal("再次登录不应创建新用户")
+	}
+}
+
+func TestSocialLoginLinksVerifiedEmail(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	existing := &user.User{Email: "linked@example.com", Password: "hash"}
+	_ = s.userDAO.Create(ctx, existing)
+	_ = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID: existing.ID, Type: consts.IdentityTypeEmail, Identifier: existing.Email, Verified: true,
+	})
+	socialServer.AddUser(mockprovider.User{Subject: "link-1", Email: "linked@example.com", EmailVerified: true})
+
+	started, callback := startSocialLogin(t, s, "link-1")
+	if _, err := finishSocialLogin(s, started, callback); err != nil {
+		t.Fatalf("第三方登录失败: %v", err)
+	}
+
+	linked, _ := s.identityDAO.FindByIdentifier(ctx, consts.IdentityTypeSocialPrefix+testSocialProvider, "link-1")
+	if linked == nil || linked.UserID != existing.ID {
+		t.Fatal("应按已验证的邮箱关联已有用户")
+	}
+	if len(s.userDAO.(*memUserDAO).users) != 1 {
+		t.Fatal("不应创建新用户")
+	}
+}
+
+func TestSocialLoginRejectsUnverifiedEmail(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	existing := &user.User{Email: "victim@example.com", Password: "hash"}
+	_ = s.userDAO.Create(ctx, existing)
+	_ = s.identityDAO.Create(ctx, &identity.Identity{
+		UserID: existing.ID, Type: consts.IdentityTypeEmail, Identifier: existing.Email, Verified: true,
+	})
+	socialServer.AddUser(mockprovider.User{Subject: "unverified-1", Email: "victim@example.com", EmailVerified: false})
+
+	started, callback := startSocialLogin(t, s, "unverified-1")
+	_, err := finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrSocialEmail)
+
+	linked, _ := s.identityDAO.FindByIdentifier(ctx, consts.IdentityTypeSocialPrefix+testSocialProvider, "unverified-1")
+	if linked != nil {
+		t.Fatal("未验证的邮箱不能关联已有用户")
+	}
+}
+
+func TestSocialLoginRejectsStateMismatch(t *testing.T) {
+	s := newTestService()
+	socialServer.AddUser(mockprovider.User{Subject: "state-1", Email: "state@example.com", EmailVerified: true})
+
+	// 回调中的state与发起登录的浏览器cookie不一致
+	started, callback := startSocialLogin(t, s, "state-1")
+	other, _ := startSocialLogin(t, s, "state-1")
+	_, err := finishSocialLogin(s, other, callback)
+	assertAppError(t, err, consts.ErrSocialState)
+
+	// 没有cookie
+	_, err = s.FinishSocialLogin(context.Background(), callback, "", "127.0.0.1", "test")
+	assertAppError(t, err, consts.ErrSocialState)
+
+	// state只能使用一次
+	if _, err = finishSocialLogin(s, started, callback); err != nil {
+		t.Fatalf("第三方登录失败: %v", err)
+	}
+	_, err = finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrSocialState)
+
+	// state属于其他服务商
+	started, callback = startSocialLogin(t, s, "state-1")
+	callback.Provider = "other"
+	_, err = finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrSocialState)
+}
+
+func TestSocialLoginUsesPKCE(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	socialServer.AddUser(mockprovider.User{Subject: "pkce-1", Email: "pkce@example.com", EmailVerified: true})
+
+	started, callback := startSocialLogin(t, s, "pkce-1")
+	authURL, _ := url.Parse(started.AuthURL)
+	if authURL.Query().Get("code_challenge") == "" || authURL.Query().Get("code_challenge_method") != consts.PKCEMethodS256 {
+		t.Fatalf("授权地址应携带S256 code_challenge: %s", started.AuthURL)
+	}
+
+	// 替换保存的code_verifier，服务商校验PKCE失败，授权码不能换取令牌
+	encoded, err := util.ConsumeSocialState(ctx, started.State)
+	if err != nil || encoded == "" {
+		t.Fatalf("读取state失败: %v", err)
+	}
+	var data socialLoginState
+	_ = json.Unmarshal([]byte(encoded), &data)
+	if data.CodeVerifier == "" {
+		t.Fatal("code_verifier应只保存在服务端")
+	}
+	data.CodeVerifier = "tampered-verifier-tampered-verifier-tampered"
+	tampered, _ := json.Marshal(data)
+	if err = util.SaveSocialState(ctx, started.State, string(tampered)); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrSocialFailed)
+}
+
+func TestSocialLoginAccessDenied(t *testing.T) {
+	s := newTestService()
+
+	started, callback := startSocialLogin(t, s, "deny")
+	if callback.Error != OAuthErrAccessDenied {
+		t.Fatalf("error = %q, want access_denied", callback.Error)
+	}
+	_, err := finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrSocialFailed)
+}
+
+func TestSocialLoginUnknownProvider(t *testing.T) {
+	s := newTestService()
+	_, err := s.StartSocialLogin(context.Background(), &StartSocialLoginReq{Provider: "unknown"})
+	assertAppError(t, err, consts.ErrSocialProvider)
+}
+
+func TestSocialLoginRejectsDirectoryDomain(t *testing.T) {
+	s := newTestService()
+	ctx := context.Background()
+	email := addDirectoryUser("social", "directory-password")
+	socialServer.AddUser(mockprovider.User{Subject: "directory-1", Email: email, EmailVerified: true})
+
+	// 目录用户首次登录之前，也不能通过第三方账号创建本地用户
+	started, callback := startSocialLogin(t, s, "directory-1")
+	_, err := finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+	if len(s.userDAO.(*memUserDAO).users) != 0 {
+		t.Fatal("不应为目录管理的邮箱创建用户")
+	}
+
+	// 已关联第三方账号的用户改由目录管理后，不能再通过第三方账号登录
+	socialServer.AddUser(mockprovider.User{Subject: "migrated-1", Email: "migrated@example.com", EmailVerified: true})
+	started, callback = startSocialLogin(t, s, "migrated-1")
+	if _, err = finishSocialLogin(s, started, callback); err != nil {
+		t.Fatalf("第三方登录失败: %v", err)
+	}
+	migrated, _ := s.findUserByIdentity(ctx, emailIdentity("migrated@example.com"))
+	migrated.CredentialSource = consts.CredentialSourceLDAP
+	_ = s.userDAO.Update(ctx, migrated)
+
+	started, callback = startSocialLogin(t, s, "migrated-1")
+	_, err = finishSocialLogin(s, started, callback)
+	assertAppError(t, err, consts.ErrDirectoryLogin)
+}
@@ -30,16 +30,124 @@ type EmailConfig struct {
 
 // JWT配置
 type JWTConfig struct {
-	Secret     string
-	ExpireTime int64 // token过期时间，单位秒
+	Issuer            string   // 签发者标识，写入token的iss，同时作为OIDC的issuer
+	Secret            string   // HS256密钥，未配置SigningKeyID时使用
+	ExpireTime        int64    // access token过期时间，单位秒
+	RefreshExpireTime int64    // refresh token过期时间，单位秒
+	SigningKeyID      string   // 当前用于签名的非对称密钥ID
+	Keys              []JWTKey // 非对称密钥环，轮换时保留旧密钥以继续验证其签发的token
+	LegacyHS256Until  string   // 配置SigningKeyID后，在此时间之前（RFC 3339格式）继续接受不带kid的HS256旧token；为空时不接受
+}
+
+// JWTKey 非对称签名密钥
+type JWTKey struct {
+	KeyID      string // 密钥ID，写入token头部的kid
+	Algorithm  string // 签名算法：RS256、ES256、EdDSA，为空时根据密钥类型推断
+	PrivateKey string // PEM格式私钥，只用于验证的旧密钥可不配置
+	PublicKey  string // PEM格式公钥，配置了私钥时可省略
+}
+
+// PasswordPolicyConfig 密码策略配置，注册、重置密码、修改密码时校验
+type PasswordPolicyConfig struct {
+	MinLength            int    // 最小长度（字符数）
+	MaxLength            int    // 最大长度（字节数），bcrypt只使用前72字节，超过72时按72处理
+	MinCharClasses       int    // 至少包含的字符类别数：小写字母、大写字母、数字、符号
+	RejectEmailLocalPart bool   // 拒绝包含邮箱@前部分的密码
+	CommonPasswordFile   string // 常见密码列表文件，每行一个；为空时使用内置列表
+	BreachedRangeDir     string // 泄露密码库目录，HIBP range格式，按SHA-1前5位分桶
+	BreachedIndexFile    string // 泄露密码库二进制索引，排序的20字节SHA-1摘要
+	BreachedMinCount     int    // range格式中出现次数达到该值才视为泄露，默认1
+	FlagBreachedOnLogin  bool   // 登录时检查密码是否已泄露，泄露则标记用户需要修改密码
+	HistorySize          int    // 新密码不能与最近N个密码相同（包括当前密码），0表示不限制
+}
+
+// PasswordHashConfig 密码哈希配置，修改后已有用户在下次登录时自动按新配置重新计算哈希
+type PasswordHashConfig struct {
+	Algorithm         string // 哈希算法：argon2id（默认）、bcrypt
+	BcryptCost        int    // bcrypt cost，为0时使用bcrypt.DefaultCost
+	Argon2Memory      uint32 // argon2id内存，单位KiB
+	Argon2Time        uint32 // argon2id迭代次数
+	Argon2Parallelism uint8  // argon2id并行度
+}
+
+// MagicLinkConfig 登录链接配置
+type MagicLinkConfig struct {
+	ConsumeURL   string // 邮件中链接指向的地址，token以查询参数附加；可指向前端页面，由前端调用/api/auth/magic/consume
+	SecureCookie bool   // nonce cookie是否只通过HTTPS发送，生产环境应开启
+}
+
+// MFAConfig 两步验证配置
+type MFAConfig struct {
+	Issuer        string // 验证器App中显示的服务名称
+	EncryptionKey string // 加密TOTP密钥的AES-256密钥，base64编码的32字节，没有默认值，未配置时不能开启两步验证；更换后已绑定的用户需要重新绑定
+}
+
+// WebAuthnConfig 通行密钥配置
+type WebAuthnConfig struct {
+	RPID          string   // 依赖方ID，通常为不含协议和端口的域名；更换后已注册的通行密钥不能再使用
+	RPDisplayName string   // 浏览器和验证器中显示的服务名称
+	RPOrigins     []string // 允许发起通行密钥请求的前端origin，必须是完整的origin
+}
+
+// SMSConfig 短信配置
+type SMSConfig struct {
+	Provider      string // 短信发送方式，为空时不能发送短信；log只打印日志并写入OutputFile，仅用于本地开发和测试
+	OutputFile    string // log方式下追加写入短信内容的文件，留空则只打印日志
+	DefaultRegion string // 手机号未带国际区号时使用的默认地区，ISO 3166-1二位代码，如CN
+}
+
+// SocialLoginConfig 第三方登录配置
+type SocialLoginConfig struct {
+	Providers    []SocialProviderConfig // 已接入的第三方登录服务商
+	SecureCookie bool                   // state cookie是否只通过HTTPS发送，生产环境应开启
+}
+
+// SocialProviderConfig 第三方登录服务商配置，支持OIDC和标准OAuth 2.0授权码模式
+type SocialProviderConfig struct {
+	Name               string   // 服务商名称，只能包含小写字母、数字和-，用于路由/api/auth/oauth/{Name}/start，如github
+	ClientID           string   // 在服务商处注册的client_id
+	ClientSecret       string   // 在服务商处注册的client_secret
+	Issuer             string   // OIDC服务商的issuer，配置后从发现文档中获取未配置的端点
+	AuthURL            string   // 授权端点
+	TokenURL           string   // 令牌端点
+	UserInfoURL        string   // 用户信息端点
+	RedirectURL        string   // 在服务商处登记的回调地址，指向/api/auth/oauth/{Name}/callback或提交回调参数的前端页面
+	Scopes             []string // 申请的授权范围，OIDC服务商通常为openid email profile
+	SubjectField       string   // 用户信息中账号唯一ID的字段，默认sub
+	EmailField         string   // 用户信息中邮箱的字段，默认email
+	EmailVerifiedField string   // 用户信息中邮箱验证状态的字段，默认email_verified
+	TrustEmail         bool     // 服务商只返回已验证的邮箱（如GitHub的公开邮箱），不检查验证状态字段
+	DisablePKCE        bool     // 服务商不支持PKCE时关闭，只依靠state防止CSRF
+}
+
+// LDAPConfig LDAP目录配置，由目录管理的用户登录时通过LDAP简单绑定校验密码
+type LDAPConfig struct {
+	URL             string            // 目录地址，ldap://host:389或ldaps://host:636，为空时不启用
+	BindDN          string            // 搜索用户使用的服务账号DN，为空时匿名搜索
+	BindPassword    string            // 服务账号密码
+	BaseDN          string            // 搜索用户的起始DN，如ou=people,dc=example,dc=com
+	UserObjectClass string            // 用户条目的objectClass，默认person
+	EmailAttribute  string            // 用户条目中邮箱的属性，默认mail
+	GroupAttribute  string            // 用户条目中所属组DN的属性，默认memberOf
+	GroupRoles      map[string]string // 组DN到用户角色的映射，DN不区分大小写；匹配多个时admin优先，都不匹配时为user
+	Domains         []string          // 由目录管理的邮箱域名，如example.com；这些邮箱登录时使用LDAP校验密码，本地没有的用户首次登录时自动创建
+	Timeout         int               // 连接和请求的超时时间，单位秒
 }
 
 // AppConfig 应用配置
 type AppConfig struct {
-	MongoDB MongoDBConfig
-	Redis   RedisConfig
-	Email   EmailConfig
-	JWT     JWTConfig
+	MongoDB        MongoDBConfig
+	Redis          RedisConfig
+	Email          EmailConfig
+	JWT            JWTConfig
+	PasswordPolicy PasswordPolicyConfig
+	PasswordHash   PasswordHashConfig
+	MagicLink      MagicLinkConfig
+	MFA            MFAConfig
+	WebAuthn       WebAuthnConfig
+	SMS            SMSConfig
+	SocialLogin    SocialLoginConfig
+	LDAP           LDAPConfig
 }
 
 // ConfigInstance 单例实例
@@ -69,8 +177,44 @@ func GetConfig() *AppConfig {
 				Password: "adxhpprrbbnuiegc",
 			},
 			JWT: JWTConfig{
-				Secret:     " J3w8*Lm!7z@q#P1x",
-				ExpireTime: 86400, // 24小时（86400 秒）
+				Issuer:            "http://localhost:8888",
+				Secret:            " J3w8*Lm!7z@q#P1x",
+				ExpireTime:        900,    // 15分钟（900 秒）
+				RefreshExpireTime: 604800, // 7天（604800 秒）
+			},
+			PasswordPolicy: PasswordPolicyConfig{
+				MinLength:            8,
+				MaxLength:            72,
+				MinCharClasses:       2,
+				RejectEmailLocalPart: true,
+				HistorySize:          5,
+			},
+			PasswordHash: PasswordHashConfig{
+				Algorithm:         "argon2id",
+				BcryptCost:        10,
+				Argon2Memory:      19 * 1024, // 19MiB
+				Argon2Time:        2,
+				Argon2Parallelism: 1,
+			},
+			MagicLink: MagicLinkConfig{
+				ConsumeURL: "http://localhost:8888/api/auth/magic/consume",
+			},
+			MFA: MFAConfig{
+				Issuer: "Auth Service",
+			},
+			WebAuthn: WebAuthnConfig{
+				RPID:          "localhost",
+				RPDisplayName: "Auth Service",
+				RPOrigins:     []string{"http://localhost:8888"},
+			},
+			SMS: SMSConfig{
+				DefaultRegion: "CN",
+			},
+			LDAP: LDAPConfig{
+				UserObjectClass: "person",
+				EmailAttribute:  "mail",
+				GroupAttribute:  "memberOf",
+				Timeout:         5,
 			},
 		}
 	})
@@ -10,6 +10,16 @@ const (
 	CodeExpire      = 60 * 5       // 验证码过期时间，5分钟
 	CodeRedisPrefix = "auth:code:" // 验证码Redis前缀
 
+	// 验证码用途，不同用途的验证码分开存储，注册验证码不能用于重置密码或登录
+	CodePurposeResetPassword = "reset_password" // 重置密码
+	CodePurposeLogin         = "login"          // 验证码登录
+	CodePurposeIdentity      = "identity"       // 绑定新的邮箱或手机号
+
+	// 登录标识类型，验证码、注册和登录接口可以使用邮箱或手机号
+	IdentityTypeEmail  = "email" // 邮箱
+	IdentityTypePhone  = "phone" // 手机号，统一保存为E.164格式
+	IdentityMaxPerUser = 10      // 每个用户最多绑定的邮箱和手机号数量
+
 	// 验证码发送频率限制
 	CodeCooldownPrefix  = "auth:cooldown:"   // 验证码冷却前缀
 	CodeFirstCooldown   = 30                 // 首次发送后冷却时间，30秒
@@ -29,8 +39,12 @@ const (
 	LoginLockTime        = 60 * 30                  // 登录锁定时间，30分钟
 
 	// 用户相关
-	UserCollection       = "users"       // 用户集合名
-	CredentialCollection = "credentials" // 登录凭证集合名
+	UserCollection           = "users"            // 用户集合名
+	CredentialCollection     = "credentials"      // 登录标识（邮箱、手机号）集合名
+	SessionCollection        = "sessions"         // 登录会话集合名
+	ClientCollection         = "oauth_clients"    // OAuth客户端集合名
+	ServiceAccountCollection = "service_accounts" // 服务账号集合名
+	PasskeyCollection        = "passkeys"         // 通行密钥集合名
 
 	// 角色相关
 	RoleAdmin = "admin" // 管理员角色
@@ -40,9 +54,89 @@ const (
 	TokenType   = "Bearer"        // Token类型
 	TokenHeader = "Authorization" // 请求头名称
 
-	// Token黑名单相关
-	TokenBlacklistPrefix = "auth:blacklist:" // Token黑名单前缀
-	TokenBlacklistExpire = 60 * 60 * 24 * 7  // Token黑名单过期时间，7天
+	// Token吊销相关
+	TokenBlacklistPrefix    = "auth:blacklist:"     // Token黑名单前缀，按jti吊销单个token
+	TokenRevokeBeforePrefix = "auth:revoke_before:" // 用户token吊销时间前缀，吊销该时间之前签发的所有token
+	TokenIDBytes            = 16                    // jti随机字节数
+
+	// Refresh Token相关
+	RefreshTokenPrefix         = "auth:refresh:"                // refresh token前缀
+	RefreshTokenUsedPrefix     = "auth:refresh_used:"           // 已轮换的refresh token前缀
+	RefreshFamilyRevokedPrefix = "auth:refresh_family_revoked:" // 已吊销的refresh token族前缀
+	RefreshTokenBytes          = 32                             // refresh token随机字节数
+
+	// 会话相关
+	SessionRevokedPrefix = "auth:session_revoked:" // 已吊销会话前缀
+
+	// OAuth相关
+	ClientTypePublic       = "public"                // 公开客户端（SPA、移动端），无法保管密钥，必须使用PKCE
+	ClientTypeConfidential = "confidential"          // 机密客户端，使用client_secret认证
+	ClientIDBytes          = 16                      // client_id随机字节数
+	ClientSecretBytes      = 32                      // client_secret随机字节数
+	OAuthCodePrefix        = "auth:oauth_code:"      // 授权码前缀
+	OAuthCodeUsedPrefix    = "auth:oauth_code_used:" // 已使用授权码前缀
+	OAuthCodeBytes         = 32                      // 授权码随机字节数
+	OAuthCodeExpire        = 60                      // 授权码过期时间，60秒
+	PKCEMethodS256         = "S256"                  // 唯一支持的PKCE方法
+
+	// 登录链接相关
+	MagicLinkPrefix      = "auth:magic_link:" // 未使用的登录链接前缀，按jti保存，使用时原子删除
+	MagicLinkExpire      = 60 * 10            // 登录链接过期时间，10分钟
+	MagicLinkAudience    = "magic_link"       // 登录链接token的aud，不能作为access token或ID Token使用
+	MagicLinkNonceCookie = "magic_link_nonce" // 绑定发起登录浏览器的nonce cookie
+	MagicLinkCookiePath  = "/api/auth/magic"  // nonce cookie只随登录链接相关请求发送
+	MagicLinkNonceBytes  = 32                 // nonce随机字节数
+
+	// 两步验证相关
+	TOTPDigits         = 6                   // TOTP验证码位数
+	TOTPPeriod         = 30                  // TOTP时间步长，30秒
+	TOTPSkew           = 1                   // 允许前后各1个时间步的时钟偏差
+	TOTPSecretBytes    = 20                  // TOTP密钥随机字节数
+	TOTPEnrollPrefix   = "auth:totp_enroll:" // 待确认TOTP密钥前缀
+	TOTPEnrollExpire   = 60 * 10             // 待确认TOTP密钥过期时间，10分钟
+	TOTPUsedPrefix     = "auth:totp_used:"   // 已使用TOTP时间步前缀，防止同一验证码重放
+	MFAPendingPrefix   = "auth:mfa_pending:" // 待完成两步验证的登录前缀，按jti保存
+	MFAPendingExpire   = 60 * 5              // mfa token过期时间，5分钟
+	MFAPendingAudience = "mfa_pending"       // mfa token的aud，不能作为access token使用
+	RecoveryCodeCount  = 10                  // 每次生成的恢复码数量
+	RecoveryCodeBytes  = 10                  // 恢复码随机字节数，base32编码后为16个字符
+
+	// 通行密钥相关
+	PasskeyCeremonyPrefix   = "auth:passkey_ceremony:" // 进行中的注册或登录仪式前缀，保存challenge等会话数据
+	PasskeyCeremonyExpire   = 60 * 5                   // 注册或登录仪式过期时间，5分钟
+	PasskeyCeremonyBytes    = 16                       // 仪式ID随机字节数
+	PasskeyCeremonyRegister = "register"               // 注册仪式
+	PasskeyCeremonyLogin    = "login"                  // 登录仪式
+	PasskeyMaxPerUser       = 20                       // 每个用户最多注册的通行密钥数量
+	PasskeyNameMaxLength    = 64                       // 通行密钥名称最大长度（字符数）
+
+	// 第三方登录相关
+	SocialStatePrefix        = "auth:social_state:" // 进行中的第三方登录前缀，按state保存code_verifier等数据，回调时原子删除
+	SocialStateExpire        = 60 * 10              // 第三方登录state过期时间，10分钟
+	SocialStateBytes         = 32                   // state随机字节数
+	SocialStateCookie        = "social_login_state" // 绑定发起登录浏览器的state cookie
+	SocialCookiePath         = "/api/auth/oauth"    // state cookie只随第三方登录相关请求发送
+	SocialCodeVerifierBytes  = 32                   // PKCE code_verifier随机字节数，base64url编码后为43个字符
+	IdentityTypeSocialPrefix = "social:"            // 第三方账号登录标识类型前缀，如social:github，标识为服务商内的账号ID
+
+	// 密码来源
+	CredentialSourceLocal = ""     // 密码哈希保存在本地用户集合中
+	CredentialSourceLDAP  = "ldap" // 密码由LDAP目录管理，登录时通过目录绑定校验
+
+	// token主体类型
+	SubjectTypeUser    = "user"    // 用户
+	SubjectTypeService = "service" // 服务账号
+
+	// 授权范围
+	ScopeOpenID  = "openid"  // OpenID Connect，签发ID Token
+	ScopeEmail   = "email"   // 邮箱及其验证状态
+	ScopeProfile = "profile" // 基本资料
+
+	// 第一方接口授权范围，JWTAuth之后由RequireScopes中间件校验
+	ScopeAuthRead     = "auth:read"                          // 读取账号数据（用户信息、会话、客户端列表等）
+	ScopeAuthWrite    = "auth:write"                         // 修改账号数据（吊销会话、管理客户端等）
+	ScopeIntrospect   = "auth:introspect"                    // 调用令牌自省接口，只授予资源服务器和网关使用的服务账号
+	DefaultLoginScope = ScopeAuthRead + " " + ScopeAuthWrite // 登录未指定授权范围时授予全部第一方授权范围
 
 	// MongoDB相关
 	MongoTimeout = 10 // MongoDB操作超时时间(秒)
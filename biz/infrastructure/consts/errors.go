@@ -10,17 +10,33 @@ const (
 	ErrNotFound     = 1004 // 资源不存在
 
 	// 用户相关错误: 2000-2999
-	ErrUserNotExist       = 2000 // 用户不存在
-	ErrUserAlreadyExist   = 2001 // 用户已存在
-	ErrPasswordIncorrect  = 2002 // 密码错误
-	ErrVerifyCodeExpired  = 2003 // 验证码已过期
-	ErrVerifyCodeInvalid  = 2004 // 验证码无效
-	ErrPermissionDenied   = 2005 // 权限不足
-	ErrUserKicked         = 2006 // 用户已被踢出
-	ErrCodeTooFrequent    = 2007 // 验证码发送过于频繁
-	ErrAccountFrozen      = 2008 // 账号已被冻结
-	ErrLoginLocked        = 2009 // 登录已被锁定
-	ErrInvalidCredentials = 2010 // 账号或密码错误
+	ErrUserNotExist        = 2000 // 用户不存在
+	ErrUserAlreadyExist    = 2001 // 用户已存在
+	ErrPasswordIncorrect   = 2002 // 密码错误
+	ErrVerifyCodeExpired   = 2003 // 验证码已过期
+	ErrVerifyCodeInvalid   = 2004 // 验证码无效
+	ErrPermissionDenied    = 2005 // 权限不足
+	ErrUserKicked          = 2006 // 用户已被踢出
+	ErrCodeTooFrequent     = 2007 // 验证码发送过于频繁
+	ErrAccountFrozen       = 2008 // 账号已被冻结
+	ErrLoginLocked         = 2009 // 登录已被锁定
+	ErrInvalidCredentials  = 2010 // 账号或密码错误
+	ErrPasswordPolicy      = 2011 // 密码不符合安全策略
+	ErrMFACodeInvalid      = 2012 // 动态验证码无效
+	ErrMFAAlreadyEnabled   = 2013 // 已开启两步验证
+	ErrMFANotEnabled       = 2014 // 未开启两步验证
+	ErrMFAEnrollExpired    = 2015 // 两步验证绑定已过期
+	ErrPasskeyInvalid      = 2016 // 通行密钥验证失败
+	ErrPasskeyExists       = 2017 // 通行密钥已注册
+	ErrPasskeyCloned       = 2018 // 通行密钥签名计数异常
+	ErrPasskeyLimit        = 2019 // 通行密钥数量已达上限
+	ErrRecoveryCodeInvalid = 2020 // 恢复码无效
+	ErrPhoneInvalid        = 2021 // 手机号无效
+	ErrIdentityExists      = 2022 // 登录标识已被使用
+	ErrIdentityLast        = 2023 // 不能解绑唯一的登录标识
+	ErrIdentityLimit       = 2024 // 登录标识数量已达上限
+	ErrPasswordManaged     = 2025 // 密码由LDAP目录管理
+	ErrDirectoryLogin      = 2026 // 目录用户只能使用目录密码登录
 
 	// 数据库错误: 3000-3999
 	ErrDatabase = 3000 // 数据库错误
@@ -28,10 +44,22 @@ const (
 	ErrRedis    = 3002 // Redis错误
 
 	// 鉴权错误: 4000-4999
-	ErrTokenInvalid    = 4000 // Token无效
-	ErrTokenExpired    = 4001 // Token已过期
-	ErrTokenGenerating = 4002 // Token生成失败
-	ErrTokenBlacklist  = 4003 // Token已被拉黑
+	ErrTokenInvalid     = 4000 // Token无效
+	ErrTokenExpired     = 4001 // Token已过期
+	ErrTokenGenerating  = 4002 // Token生成失败
+	ErrTokenBlacklist   = 4003 // Token已被拉黑
+	ErrRefreshInvalid   = 4004 // Refresh Token无效
+	ErrRefreshReused    = 4005 // Refresh Token被重复使用
+	ErrMagicLinkInvalid = 4006 // 登录链接无效
+	ErrMagicLinkBrowser = 4007 // 登录链接不是在发起登录的浏览器中打开
+	ErrMFATokenInvalid  = 4008 // mfa token无效
+	ErrPasskeyCeremony  = 4009 // 通行密钥注册或登录请求无效
+	ErrSocialProvider   = 4010 // 不支持的第三方登录服务商
+	ErrSocialState      = 4011 // 第三方登录请求无效
+	ErrSocialFailed     = 4012 // 第三方登录失败
+	ErrSocialEmail      = 4013 // 第三方账号没有已验证的邮箱
+	ErrLDAPUnavailable  = 4014 // LDAP目录不可用
+	ErrMFAUnavailable   = 4015 // 未配置两步验证加密密钥
 )
 
 // 错误信息映射
@@ -44,17 +72,33 @@ var ErrMsg = map[int]string{
 	ErrNotFound:     "资源不存在",
 
 	// 用户相关错误
-	ErrUserNotExist:       "用户不存在",
-	ErrUserAlreadyExist:   "用户已存在",
-	ErrPasswordIncorrect:  "密码错误",
-	ErrVerifyCodeExpired:  "验证码已过期",
-	ErrVerifyCodeInvalid:  "验证码无效",
-	ErrPermissionDenied:   "权限不足，需要管理员权限",
-	ErrUserKicked:         "用户已被踢出",
-	ErrCodeTooFrequent:    "验证码发送过于频繁，请稍后再试",
-	ErrAccountFrozen:      "账号已被冻结，请30分钟后再试",
-	ErrLoginLocked:        "登录失败次数过多，账号已被锁定，请30分钟后再试",
-	ErrInvalidCredentials: "账号或密码错误",
+	ErrUserNotExist:        "用户不存在",
+	ErrUserAlreadyExist:    "用户已存在",
+	ErrPasswordIncorrect:   "密码错误",
+	ErrVerifyCodeExpired:   "验证码已过期",
+	ErrVerifyCodeInvalid:   "验证码无效",
+	ErrPermissionDenied:    "权限不足，需要管理员权限",
+	ErrUserKicked:          "用户已被踢出",
+	ErrCodeTooFrequent:     "验证码发送过于频繁，请稍后再试",
+	ErrAccountFrozen:       "账号已被冻结，请30分钟后再试",
+	ErrLoginLocked:         "登录失败次数过多，账号已被锁定，请30分钟后再试",
+	ErrInvalidCredentials:  "账号或密码错误",
+	ErrPasswordPolicy:      "密码不符合安全策略",
+	ErrMFACodeInvalid:      "动态验证码无效",
+	ErrMFAAlreadyEnabled:   "已开启两步验证",
+	ErrMFANotEnabled:       "未开启两步验证",
+	ErrMFAEnrollExpired:    "两步验证绑定已过期，请重新开始",
+	ErrPasskeyInvalid:      "通行密钥验证失败",
+	ErrPasskeyExists:       "该通行密钥已注册",
+	ErrPasskeyCloned:       "通行密钥签名计数异常，可能已被复制，请联系管理员",
+	ErrPasskeyLimit:        "通行密钥数量已达上限",
+	ErrRecoveryCodeInvalid: "恢复码无效或已被使用",
+	ErrPhoneInvalid:        "手机号格式不正确",
+	ErrIdentityExists:      "该邮箱或手机号已被其他账号使用",
+	ErrIdentityLast:        "不能解绑账号唯一的邮箱或手机号",
+	ErrIdentityLimit:       "绑定的邮箱和手机号数量已达上限",
+	ErrPasswordManaged:     "该账号的密码由企业目录管理，请在目录中修改",
+	ErrDirectoryLogin:      "该账号由企业目录管理，请使用目录账号密码登录",
 
 	// 数据库错误
 	ErrDatabase: "数据库错误",
@@ -62,10 +106,22 @@ var ErrMsg = map[int]string{
 	ErrRedis:    "Redis错误",
 
 	// 鉴权错误
-	ErrTokenInvalid:    "无效的Token",
-	ErrTokenExpired:    "Token已过期",
-	ErrTokenGenerating: "Token生成失败",
-	ErrTokenBlacklist:  "Token已被加入黑名单",
+	ErrTokenInvalid:     "无效的Token",
+	ErrTokenExpired:     "Token已过期",
+	ErrTokenGenerating:  "Token生成失败",
+	ErrTokenBlacklist:   "Token已被加入黑名单",
+	ErrRefreshInvalid:   "Refresh Token无效或已过期",
+	ErrRefreshReused:    "Refresh Token已被使用，登录会话已失效，请重新登录",
+	ErrMagicLinkInvalid: "登录链接无效、已过期或已被使用",
+	ErrMagicLinkBrowser: "请在发起登录的浏览器中打开登录链接",
+	ErrMFATokenInvalid:  "两步验证已过期，请重新登录",
+	ErrPasskeyCeremony:  "通行密钥请求无效或已过期，请重试",
+	ErrSocialProvider:   "不支持该第三方登录方式",
+	ErrSocialState:      "第三方登录请求无效或已过期，请重试",
+	ErrSocialFailed:     "第三方登录失败，请重试",
+	ErrSocialEmail:      "第三方账号没有已验证的邮箱，无法登录",
+	ErrLDAPUnavailable:  "企业目录服务暂时不可用，请稍后重试",
+	ErrMFAUnavailable:   "服务端未配置两步验证，暂不能开启",
 }
 
 // ErrorWithCode 带错误码的错误接口
@@ -74,10 +130,17 @@ type ErrorWithCode interface {
 	ErrorCode() int
 }
 
+// ErrorWithData 携带结构化错误详情的错误接口
+type ErrorWithData interface {
+	ErrorWithCode
+	ErrorData() interface{}
+}
+
 // AppError 应用错误结构体
 type AppError struct {
-	Code int    // 错误码
-	Msg  string // 错误信息
+	Code int         // 错误码
+	Msg  string      // 错误信息
+	Data interface{} // 错误详情，可选
 }
 
 // Error 实现error接口
@@ -90,6 +153,11 @@ func (e *AppError) ErrorCode() int {
 	return e.Code
 }
 
+// ErrorData 获取错误详情
+func (e *AppError) ErrorData() interface{} {
+	return e.Data
+}
+
 // NewAppError 创建应用错误
 func NewAppError(code int, msg string) *AppError {
 	return &AppError{
@@ -105,3 +173,12 @@ func NewAppErrorWithCode(code int) *AppError {
 		Msg:  ErrMsg[code],
 	}
 }
+
+// NewAppErrorWithData 根据错误码创建携带错误详情的应用错误
+func NewAppErrorWithData(code int, data interface{}) *AppError {
+	return &AppError{
+		Code: code,
+		Msg:  ErrMsg[code],
+		Data: data,
+	}
+}
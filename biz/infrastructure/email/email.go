@@ -2,8 +2,10 @@ package email
 
 import (
 	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
 	"crypto/tls"
 	"fmt"
+	"html"
 	"math/rand"
 	"net/smtp"
 	"strconv"
@@ -142,6 +144,81 @@ func SendVerificationCode(to, code string) error {
 	return SendEmail(to, subject, htmlBody)
 }
 
+// SendPasswordResetCode 发送重置密码验证码邮件
+func SendPasswordResetCode(to, code string) error {
+	subject := "验证码 - 重置密码"
+	fmt.Println("准备发送重置密码邮件至:", to)
+
+	// 构建HTML邮件内容
+	htmlBody := fmt.Sprintf(`
+		<div style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px; border: 1px solid #e0e0e0; border-radius: 5px;">
+			<h2 style="color: #333;">重置密码</h2>
+			<p style="font-size: 16px; color: #666;">您好，</p>
+			<p style="font-size: 16px; color: #666;">您正在重置账号密码，验证码是：</p>
+			<div style="background-color: #f5f5f5; padding: 15px; text-align: center; font-size: 24px; font-weight: bold; letter-spacing: 5px; margin: 20px 0;">
+				%s
+			</div>
+			<p style="font-size: 14px; color: #999;">验证码有效期为5分钟，请勿泄露给他人。重置成功后，所有设备上的登录都将失效。</p>
+			<p style="font-size: 14px; color: #999;">如果您没有申请重置密码，请忽略此邮件，您的密码不会被修改。</p>
+			<div style="margin-top: 30px; padding-top: 20px; border-top: 1px solid #e0e0e0; text-align: center; color: #999; font-size: 12px;">
+				此邮件由系统自动发送，请勿回复。
+			</div>
+		</div>
+	`, code)
+
+	return SendEmail(to, subject, htmlBody)
+}
+
+// SendLoginCode 发送登录验证码邮件
+func SendLoginCode(to, code string) error {
+	subject := "验证码 - 登录"
+	fmt.Println("准备发送登录验证码邮件至:", to)
+
+	// 构建HTML邮件内容
+	htmlBody := fmt.Sprintf(`
+		<div style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px; border: 1px solid #e0e0e0; border-radius: 5px;">
+			<h2 style="color: #333;">登录验证码</h2>
+			<p style="font-size: 16px; color: #666;">您好，</p>
+			<p style="font-size: 16px; color: #666;">您正在使用验证码登录，验证码是：</p>
+			<div style="background-color: #f5f5f5; padding: 15px; text-align: center; font-size: 24px; font-weight: bold; letter-spacing: 5px; margin: 20px 0;">
+				%s
+			</div>
+			<p style="font-size: 14px; color: #999;">验证码有效期为5分钟，请勿泄露给他人。任何人持有此验证码都可以登录您的账号。</p>
+			<p style="font-size: 14px; color: #999;">如果您没有申请登录，请忽略此邮件，并考虑修改密码。</p>
+			<div style="margin-top: 30px; padding-top: 20px; border-top: 1px solid #e0e0e0; text-align: center; color: #999; font-size: 12px;">
+				此邮件由系统自动发送，请勿回复。
+			</div>
+		</div>
+	`, code)
+
+	return SendEmail(to, subject, htmlBody)
+}
+
+// SendMagicLink 发送登录链接邮件
+func SendMagicLink(to, link string) error {
+	subject := "登录链接"
+	fmt.Println("准备发送登录链接邮件至:", to)
+
+	// 构建HTML邮件内容，链接中的token只能使用一次
+	htmlBody := fmt.Sprintf(`
+		<div style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px; border: 1px solid #e0e0e0; border-radius: 5px;">
+			<h2 style="color: #333;">登录您的账号</h2>
+			<p style="font-size: 16px; color: #666;">您好，</p>
+			<p style="font-size: 16px; color: #666;">请点击下面的按钮完成登录：</p>
+			<div style="text-align: center; margin: 30px 0;">
+				<a href="%s" style="background-color: #1677ff; color: #fff; padding: 12px 32px; border-radius: 4px; text-decoration: none; font-size: 16px;">登录</a>
+			</div>
+			<p style="font-size: 14px; color: #999;">链接有效期为%d分钟，只能使用一次，并且必须在申请登录的浏览器中打开。请勿转发给他人。</p>
+			<p style="font-size: 14px; color: #999;">如果您没有申请登录，请忽略此邮件。</p>
+			<div style="margin-top: 30px; padding-top: 20px; border-top: 1px solid #e0e0e0; text-align: center; color: #999; font-size: 12px;">
+				此邮件由系统自动发送，请勿回复。
+			</div>
+		</div>
+	`, html.EscapeString(link), consts.MagicLinkExpire/60)
+
+	return SendEmail(to, subject, htmlBody)
+}
+
 // GenerateVerificationCode 生成6位随机验证码
 func GenerateVerificationCode() string {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
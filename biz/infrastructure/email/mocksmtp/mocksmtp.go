@@ -0,0 +1,218 @@
+package mocksmtp
+
+import (
+	"auth/biz/infrastructure/config"
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Message 模拟服务器收到的邮件
+type Message struct {
+	From string
+	To   []string
+	Data string // 邮件头和正文
+}
+
+// Server 模拟的SMTP服务器，监听本机端口，用于本地开发和测试，不会真正投递邮件
+// 只支持EHLO/HELO、AUTH PLAIN（接受任意凭据）、MAIL、RCPT、DATA、RSET和QUIT
+type Server struct {
+	Addr string // 服务器地址，如127.0.0.1:38925
+
+	listener net.Listener
+	mu       sync.Mutex
+	messages []Message
+	conns    map[net.Conn]struct{}
+	closed   bool
+}
+
+// NewServer 在本机随机端口启动模拟SMTP服务器
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		Addr:     listener.Addr().String(),
+		listener: listener,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	go s.serve()
+	return s, nil
+}
+
+// Close 停止模拟服务器并断开所有连接
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for c := range s.conns {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+	return s.listener.Close()
+}
+
+// EmailConfig 指向模拟服务器的邮件配置
+// 主机使用localhost，net/smtp只允许在本机不加密地进行PLAIN认证
+func (s *Server) EmailConfig() config.EmailConfig {
+	_, port, _ := net.SplitHostPort(s.Addr)
+	p, _ := strconv.Atoi(port)
+	return config.EmailConfig{
+		Host:     "localhost",
+		Port:     p,
+		Username: "noreply@example.com",
+		Password: "mock",
+	}
+}
+
+// Messages 返回收到的全部邮件
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// LastMessageTo 返回最近一封发给to的邮件，没有时返回false
+func (s *Server) LastMessageTo(to string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		for _, rcpt := range s.messages[i].To {
+			if rcpt == to {
+				return s.messages[i], true
+			}
+		}
+	}
+	return Message{}, false
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	reply := func(lines ...string) bool {
+		for _, line := range lines {
+			if _, err := w.WriteString(line + "\r\n"); err != nil {
+				return false
+			}
+		}
+		return w.Flush() == nil
+	}
+
+	if !reply("220 localhost mock SMTP ready") {
+		return
+	}
+
+	var msg Message
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(line)
+		if i := strings.IndexByte(verb, ' '); i >= 0 {
+			verb = verb[:i]
+		}
+
+		var ok bool
+		switch verb {
+		case "EHLO":
+			ok = reply("250-localhost", "250 AUTH PLAIN")
+		case "HELO":
+			ok = reply("250 localhost")
+		case "AUTH":
+			ok = reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			msg = Message{From: pathArg(line)}
+			ok = reply("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, pathArg(line))
+			ok = reply("250 OK")
+		case "DATA":
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := readData(r)
+			if err != nil {
+				return
+			}
+			msg.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			msg = Message{}
+			ok = reply("250 OK")
+		case "RSET":
+			msg = Message{}
+			ok = reply("250 OK")
+		case "NOOP":
+			ok = reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			ok = reply("502 Command not implemented")
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+// pathArg 取出MAIL FROM:<a@b>或RCPT TO:<a@b>中的地址
+func pathArg(line string) string {
+	start := strings.IndexByte(line, '<')
+	end := strings.IndexByte(line, '>')
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+// readData 读取DATA内容直到单独一行的"."，并还原以"."开头的行
+func readData(r *bufio.Reader) (string, error) {
+	var b strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		trimmed := strings.TrimRight(line, "\r\n")
+		if trimmed == "." {
+			return b.String(), nil
+		}
+		if strings.HasPrefix(trimmed, "..") {
+			trimmed = trimmed[1:]
+		}
+		b.WriteString(trimmed)
+		b.WriteString("\r\n")
+	}
+}
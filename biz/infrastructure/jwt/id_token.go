@@ -0,0 +1,45 @@
+package jwt
+
+import (
+	"auth/biz/infrastructure/config"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ErrIDTokenKey 未配置非对称签名密钥
+// HS256签名的ID Token只能用服务端密钥验证，客户端无法校验，也不能把服务端密钥交给客户端
+var ErrIDTokenKey = errors.New("签发ID Token需要配置非对称签名密钥")
+
+// IDTokenClaims OpenID Connect ID Token的Claims
+// 调用方填写Subject、Audience和用户信息，签发者与时间在签发时设置
+type IDTokenClaims struct {
+	Nonce         string `json:"nonce,omitempty"`     // 授权请求中的nonce，原样返回用于防重放
+	AuthTime      int64  `json:"auth_time,omitempty"` // 用户完成认证的时间
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	UpdatedAt     int64  `json:"updated_at,omitempty"`
+	jwt.StandardClaims
+}
+
+// GenerateIDToken 签发ID Token，有效期与access token一致，使用与access token相同的签名密钥
+// 只使用非对称密钥签名，未配置时返回ErrIDTokenKey
+func GenerateIDToken(claims IDTokenClaims) (string, error) {
+	ring, err := GetKeyring()
+	if err != nil {
+		return "", err
+	}
+	if !ring.Asymmetric() {
+		return "", ErrIDTokenKey
+	}
+
+	jwtConfig := config.GetConfig().JWT
+
+	now := time.Now()
+	claims.Issuer = jwtConfig.Issuer
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(time.Duration(jwtConfig.ExpireTime) * time.Second).Unix()
+
+	return signToken(claims)
+}
@@ -6,38 +6,53 @@ import (
 	"auth/biz/infrastructure/util"
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 )
 
+// ErrRevocationCheck 无法检查token是否已被吊销（Redis不可用），此时既不能认为token有效，也不能认为已失效
+var ErrRevocationCheck = errors.New("检查token吊销状态失败")
+
 // Claims 定义JWT的Claims
 type Claims struct {
-	UserId string `json:"userId"` // 使用string类型与MongoDB的ObjectID兼容
-	Email  string `json:"email"`  // 添加邮箱
+	UserId        string `json:"userId"`              // 使用string类型与MongoDB的ObjectID兼容
+	Email         string `json:"email"`               // 添加邮箱
+	SessionId     string `json:"sid"`                 // 登录会话ID
+	SubType       string `json:"sub_type,omitempty"`  // 主体类型：user-用户，service-服务账号；旧token为空，视为用户
+	ClientId      string `json:"client_id,omitempty"` // 通过OAuth客户端签发时为客户端ID
+	Scope         string `json:"scope,omitempty"`     // 授权范围，空格分隔
+	IssuedAtMilli int64  `json:"iat_ms,omitempty"`    // 签发时间（Unix毫秒），iat只精确到秒，与用户的吊销时间比较时使用
 	jwt.StandardClaims
 }
 
 // GenerateToken 生成JWT Token
-func GenerateToken(userId string, email string) (string, int64, error) {
+// claims只需填写业务字段，jti、签发时间和过期时间在此设置
+func GenerateToken(claims Claims) (string, int64, error) {
 	// 获取配置
 	jwtConfig := config.GetConfig().JWT
 
+	// 生成token唯一标识，用于按token吊销
+	tokenID, err := util.GenerateTokenID()
+	if err != nil {
+		return "", 0, err
+	}
+
 	// 设置过期时间
-	expireTime := time.Now().Add(time.Duration(jwtConfig.ExpireTime) * time.Second)
-	claims := Claims{
-		UserId: userId,
-		Email:  email,
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: expireTime.Unix(),
-			IssuedAt:  time.Now().Unix(),
-			NotBefore: time.Now().Unix(),
-		},
+	now := time.Now()
+	expireTime := now.Add(time.Duration(jwtConfig.ExpireTime) * time.Second)
+	claims.IssuedAtMilli = now.UnixMilli()
+	claims.StandardClaims = jwt.StandardClaims{
+		Id:        tokenID,
+		Issuer:    jwtConfig.Issuer,
+		ExpiresAt: expireTime.Unix(),
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
 	}
 
 	// 生成Token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(jwtConfig.Secret))
+	tokenString, err := signToken(claims)
 	if err != nil {
 		return "", 0, err
 	}
@@ -45,29 +60,95 @@ func GenerateToken(userId string, email string) (string, int64, error) {
 	return tokenString, expireTime.Unix(), nil
 }
 
+// signToken 使用当前签名密钥签名，未配置非对称密钥时使用HS256
+func signToken(claims jwt.Claims) (string, error) {
+	ring, err := GetKeyring()
+	if err != nil {
+		return "", err
+	}
+
+	if ring.active == nil {
+		secret := config.GetConfig().JWT.Secret
+		if secret == "" {
+			return "", errors.New("未配置JWT签名密钥")
+		}
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	}
+
+	token := jwt.NewWithClaims(ring.active.method, claims)
+	token.Header["kid"] = ring.active.kid
+	return token.SignedString(ring.active.private)
+}
+
+// verificationKey 根据token头部的kid选择验证密钥，并校验签名算法与密钥一致，防止算法混淆
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	ring, err := GetKeyring()
+	if err != nil {
+		return nil, err
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		// 没有kid的token只能是HS256签名的token，已启用非对称密钥时只在迁移期内接受
+		secret := config.GetConfig().JWT.Secret
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || secret == "" || !acceptHS256(ring) {
+			return nil, errors.New(consts.ErrMsg[consts.ErrTokenInvalid])
+		}
+		return []byte(secret), nil
+	}
+
+	key, ok := ring.lookup(kid)
+	if !ok || token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New(consts.ErrMsg[consts.ErrTokenInvalid])
+	}
+	return key.public, nil
+}
+
+// acceptHS256 是否接受HS256签名的token
+// 未配置非对称密钥时HS256是当前签名方式；配置后只在LegacyHS256Until之前接受，防止持有共享密钥的服务继续签发token
+func acceptHS256(ring *Keyring) bool {
+	if ring.active == nil {
+		return true
+	}
+
+	until := config.GetConfig().JWT.LegacyHS256Until
+	if until == "" {
+		return false
+	}
+	deadline, err := time.Parse(time.RFC3339, until)
+	if err != nil {
+		fmt.Println("JWT.LegacyHS256Until格式错误，不再接受HS256旧token:", err)
+		return false
+	}
+	return time.Now().Before(deadline)
+}
+
+// SubjectType 获取token的主体类型，未携带sub_type的旧token视为用户token
+func (c *Claims) SubjectType() string {
+	if c.SubType == "" {
+		return consts.SubjectTypeUser
+	}
+	return c.SubType
+}
+
 // ParseToken 解析JWT Token
 func ParseToken(tokenString string) (*Claims, error) {
-	// 获取配置
-	jwtConfig := config.GetConfig().JWT
-
 	// 解析Token
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(jwtConfig.Secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, verificationKey)
 
 	if err != nil {
 		return nil, err
 	}
 
-	// 验证Token
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		// 检查token是否在黑名单中
-		inBlacklist, err := checkTokenBlacklist(claims.UserId)
+	// 验证Token，ID Token等不携带userId的token不能作为access token使用
+	if claims, ok := token.Claims.(*Claims); ok && token.Valid && claims.UserId != "" {
+		// 检查token是否已被吊销
+		revoked, err := checkTokenRevoked(claims)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w: %v", ErrRevocationCheck, err)
 		}
 
-		if inBlacklist {
+		if revoked {
 			return nil, errors.New(consts.ErrMsg[consts.ErrTokenBlacklist])
 		}
 
@@ -77,8 +158,16 @@ func ParseToken(tokenString string) (*Claims, error) {
 	return nil, errors.New(consts.ErrMsg[consts.ErrTokenInvalid])
 }
 
-// 检查token是否在黑名单中
-func checkTokenBlacklist(userID string) (bool, error) {
+// 检查token是否已被吊销，或其所属会话已被吊销
+func checkTokenRevoked(claims *Claims) (bool, error) {
 	ctx := context.Background()
-	return util.IsTokenInBlacklist(ctx, userID)
+	revoked, err := util.IsTokenRevoked(ctx, claims.Id, claims.UserId, claims.SessionId, util.TokenIssuedAtMilli(claims.IssuedAt, claims.IssuedAtMilli))
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	if claims.SessionId == "" {
+		return false, nil
+	}
+	return util.IsSessionRevoked(ctx, claims.SessionId)
 }
@@ -0,0 +1,240 @@
+package jwt
+
+import (
+	"auth/biz/infrastructure/config"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// signingKey 密钥环中的一把密钥
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer // 只用于验证的旧密钥为nil
+	public  crypto.PublicKey
+}
+
+// Keyring 非对称签名密钥环
+type Keyring struct {
+	active *signingKey            // 当前签名密钥，为nil时使用HS256
+	keys   map[string]*signingKey // 按kid索引的全部密钥
+	order  []string               // 配置顺序，用于稳定输出JWKS
+}
+
+// JWK JSON Web Key（RFC 7517）
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet JSON Web Key Set
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+var (
+	keyring     *Keyring
+	keyringOnce sync.Once
+	keyringErr  error
+)
+
+// GetKeyring 获取根据配置加载的密钥环单例
+func GetKeyring() (*Keyring, error) {
+	keyringOnce.Do(func() {
+		keyring, keyringErr = NewKeyring(config.GetConfig().JWT)
+		if keyringErr != nil {
+			fmt.Println("加载JWT密钥环失败:", keyringErr)
+		}
+	})
+	return keyring, keyringErr
+}
+
+// NewKeyring 根据JWT配置创建密钥环
+func NewKeyring(conf config.JWTConfig) (*Keyring, error) {
+	ring := &Keyring{keys: make(map[string]*signingKey)}
+
+	for _, keyConf := range conf.Keys {
+		key, err := parseSigningKey(keyConf)
+		if err != nil {
+			return nil, fmt.Errorf("密钥%s无效: %w", keyConf.KeyID, err)
+		}
+		if _, ok := ring.keys[key.kid]; ok {
+			return nil, fmt.Errorf("密钥ID重复: %s", key.kid)
+		}
+		ring.keys[key.kid] = key
+		ring.order = append(ring.order, key.kid)
+	}
+
+	if conf.SigningKeyID != "" {
+		active, ok := ring.keys[conf.SigningKeyID]
+		if !ok {
+			return nil, fmt.Errorf("签名密钥不存在: %s", conf.SigningKeyID)
+		}
+		if active.private == nil {
+			return nil, fmt.Errorf("签名密钥缺少私钥: %s", conf.SigningKeyID)
+		}
+		ring.active = active
+	}
+
+	return ring, nil
+}
+
+// lookup 根据kid查找密钥
+func (r *Keyring) lookup(kid string) (*signingKey, bool) {
+	key, ok := r.keys[kid]
+	return key, ok
+}
+
+// SigningAlgorithm 当前签名算法
+func (r *Keyring) SigningAlgorithm() string {
+	if r.active == nil {
+		return jwt.SigningMethodHS256.Alg()
+	}
+	return r.active.method.Alg()
+}
+
+// Asymmetric 是否使用非对称密钥签名，只有这种签名可以由其他服务通过JWKS公钥验证
+func (r *Keyring) Asymmetric() bool {
+	return r.active != nil
+}
+
+// JWKS 导出所有密钥的公钥，供其他服务离线验证token
+func (r *Keyring) JWKS() JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(r.order))}
+	for _, kid := range r.order {
+		key := r.keys[kid]
+		jwk := JWK{
+			Use: "sig",
+			Kid: key.kid,
+			Alg: key.method.Alg(),
+		}
+
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			jwk.Kty = "EC"
+			jwk.Crv = pub.Curve.Params().Name
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+			jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// parseSigningKey 解析单个密钥配置
+func parseSigningKey(conf config.JWTKey) (*signingKey, error) {
+	if conf.KeyID == "" {
+		return nil, errors.New("缺少密钥ID")
+	}
+
+	key := &signingKey{kid: conf.KeyID}
+
+	if conf.PrivateKey != "" {
+		private, err := parsePrivateKey(conf.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		key.private = private
+		key.public = private.Public()
+	} else if conf.PublicKey != "" {
+		public, err := parsePublicKey(conf.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		key.public = public
+	} else {
+		return nil, errors.New("未配置私钥或公钥")
+	}
+
+	method, err := signingMethodFor(conf.Algorithm, key.public)
+	if err != nil {
+		return nil, err
+	}
+	key.method = method
+
+	return key, nil
+}
+
+// parsePrivateKey 解析PEM格式私钥，支持PKCS#8、PKCS#1（RSA）和SEC 1（EC）
+func parsePrivateKey(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("私钥不是有效的PEM格式")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("不支持的私钥类型")
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("无法解析私钥")
+}
+
+// parsePublicKey 解析PEM格式公钥（PKIX）
+func parsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("公钥不是有效的PEM格式")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// signingMethodFor 确定密钥的签名算法，并校验算法与密钥类型匹配
+func signingMethodFor(alg string, public crypto.PublicKey) (jwt.SigningMethod, error) {
+	var inferred jwt.SigningMethod
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		inferred = jwt.SigningMethodRS256
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return nil, errors.New("ES256只支持P-256曲线")
+		}
+		inferred = jwt.SigningMethodES256
+	case ed25519.PublicKey:
+		inferred = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("不支持的密钥类型")
+	}
+
+	if alg != "" && alg != inferred.Alg() {
+		return nil, fmt.Errorf("签名算法%s与密钥类型不匹配", alg)
+	}
+	return inferred, nil
+}
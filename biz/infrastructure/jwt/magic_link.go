@@ -0,0 +1,58 @@
+package jwt
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// MagicLinkClaims 登录链接token的Claims，Subject为用户ID
+// 只保存浏览器nonce的摘要，链接被转发到没有nonce cookie的浏览器时无法使用
+type MagicLinkClaims struct {
+	Email     string `json:"email"`
+	NonceHash string `json:"nonce_hash"`      // 发起登录的浏览器nonce的SHA-256摘要
+	Scope     string `json:"scope,omitempty"` // 登录后授予的授权范围
+	jwt.StandardClaims
+}
+
+// GenerateMagicLinkToken 签发登录链接token，返回token及其jti
+// 调用方填写Subject、Email、NonceHash和Scope，jti、签发者、受众与时间在此设置
+func GenerateMagicLinkToken(claims MagicLinkClaims) (string, string, error) {
+	tokenID, err := util.GenerateTokenID()
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	claims.Id = tokenID
+	claims.Issuer = config.GetConfig().JWT.Issuer
+	claims.Audience = consts.MagicLinkAudience
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(time.Duration(consts.MagicLinkExpire) * time.Second).Unix()
+
+	tokenString, err := signToken(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return tokenString, tokenID, nil
+}
+
+// ParseMagicLinkToken 校验登录链接token的签名、有效期和受众
+// 是否已被使用由调用方通过Redis原子删除判断
+func ParseMagicLinkToken(tokenString string) (*MagicLinkClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &MagicLinkClaims{}, verificationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*MagicLinkClaims)
+	if !ok || !token.Valid || !claims.VerifyAudience(consts.MagicLinkAudience, true) ||
+		claims.Id == "" || claims.Subject == "" || claims.NonceHash == "" {
+		return nil, errors.New(consts.ErrMsg[consts.ErrMagicLinkInvalid])
+	}
+	return claims, nil
+}
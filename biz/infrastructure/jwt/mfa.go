@@ -0,0 +1,65 @@
+package jwt
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// MFAPendingClaims 密码校验通过、等待两步验证的mfa token的Claims，Subject为用户ID
+type MFAPendingClaims struct {
+	Email string `json:"email"`
+	Phone string `json:"phone,omitempty"` // 使用手机号注册的用户没有邮箱，按手机号检查登录锁定
+	Scope string `json:"scope,omitempty"` // 完成两步验证后授予的授权范围
+	jwt.StandardClaims
+}
+
+// LoginIdentifier 与user.LoginIdentifier一致，优先使用邮箱，没有邮箱时使用手机号
+func (c *MFAPendingClaims) LoginIdentifier() string {
+	if c.Email != "" {
+		return c.Email
+	}
+	return c.Phone
+}
+
+// GenerateMFAPendingToken 签发mfa token，返回token、jti及过期时间
+// 调用方填写Subject、Email、Phone和Scope，jti、签发者、受众与时间在此设置
+func GenerateMFAPendingToken(claims MFAPendingClaims) (string, string, int64, error) {
+	tokenID, err := util.GenerateTokenID()
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	now := time.Now()
+	claims.Id = tokenID
+	claims.Issuer = config.GetConfig().JWT.Issuer
+	claims.Audience = consts.MFAPendingAudience
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(time.Duration(consts.MFAPendingExpire) * time.Second).Unix()
+
+	tokenString, err := signToken(claims)
+	if err != nil {
+		return "", "", 0, err
+	}
+	return tokenString, tokenID, claims.ExpiresAt, nil
+}
+
+// ParseMFAPendingToken 校验mfa token的签名、有效期和受众
+// 是否已被使用由调用方通过Redis判断
+func ParseMFAPendingToken(tokenString string) (*MFAPendingClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &MFAPendingClaims{}, verificationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*MFAPendingClaims)
+	if !ok || !token.Valid || !claims.VerifyAudience(consts.MFAPendingAudience, true) ||
+		claims.Id == "" || claims.Subject == "" {
+		return nil, errors.New(consts.ErrMsg[consts.ErrMFATokenInvalid])
+	}
+	return claims, nil
+}
@@ -0,0 +1,277 @@
+package ber
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// 标签类别和构造位（X.690 8.1.2）
+const (
+	ClassUniversal   byte = 0x00
+	ClassApplication byte = 0x40
+	ClassContext     byte = 0x80
+	Constructed      byte = 0x20
+)
+
+// LDAP使用的通用类型标签
+const (
+	TagBoolean     byte = 0x01
+	TagInteger     byte = 0x02
+	TagOctetString byte = 0x04
+	TagNull        byte = 0x05
+	TagEnumerated  byte = 0x0a
+	TagSequence    byte = 0x30
+	TagSet         byte = 0x31
+)
+
+// MaxPacketSize 单个LDAP消息的最大长度，防止异常的长度字段导致分配过多内存
+const MaxPacketSize = 1 << 20
+
+// ErrInvalid BER编码无效或不受支持
+var ErrInvalid = errors.New("invalid BER encoding")
+
+// Packet BER编码的数据元素，构造类型的内容保存在Children中
+// 只支持单字节标签（标签号小于31）和定长编码，足以表示LDAPv3消息
+type Packet struct {
+	Tag      byte // 完整的标识字节：类别|构造位|标签号
+	Value    []byte
+	Children []*Packet
+}
+
+// NewSequence 创建SEQUENCE
+func NewSequence(children ...*Packet) *Packet {
+	return &Packet{Tag: TagSequence, Children: children}
+}
+
+// NewConstructed 创建指定标签的构造类型
+func NewConstructed(tag byte, children ...*Packet) *Packet {
+	return &Packet{Tag: tag | Constructed, Children: children}
+}
+
+// NewString 创建指定标签的字符串，tag为0时使用OCTET STRING
+func NewString(tag byte, value string) *Packet {
+	if tag == 0 {
+		tag = TagOctetString
+	}
+	return &Packet{Tag: tag, Value: []byte(value)}
+}
+
+// NewInteger 创建指定标签的整数，tag为0时使用INTEGER
+func NewInteger(tag byte, value int64) *Packet {
+	if tag == 0 {
+		tag = TagInteger
+	}
+	return &Packet{Tag: tag, Value: encodeInteger(value)}
+}
+
+// NewBoolean 创建BOOLEAN
+func NewBoolean(value bool) *Packet {
+	if value {
+		return &Packet{Tag: TagBoolean, Value: []byte{0xff}}
+	}
+	return &Packet{Tag: TagBoolean, Value: []byte{0x00}}
+}
+
+// IsConstructed 是否为构造类型
+func (p *Packet) IsConstructed() bool {
+	return p.Tag&Constructed != 0
+}
+
+// String 按字符串读取内容
+func (p *Packet) String() string {
+	return string(p.Value)
+}
+
+// Int 按整数读取内容，适用于INTEGER和ENUMERATED
+func (p *Packet) Int() (int64, error) {
+	if len(p.Value) == 0 || len(p.Value) > 8 {
+		return 0, ErrInvalid
+	}
+	value := int64(int8(p.Value[0]))
+	for _, b := range p.Value[1:] {
+		value = value<<8 | int64(b)
+	}
+	return value, nil
+}
+
+// Bool 按布尔值读取内容
+func (p *Packet) Bool() bool {
+	return len(p.Value) == 1 && p.Value[0] != 0
+}
+
+// Child 获取第index个子元素，不存在时返回nil
+func (p *Packet) Child(index int) *Packet {
+	if index < 0 || index >= len(p.Children) {
+		return nil
+	}
+	return p.Children[index]
+}
+
+// Bytes 编码为BER字节，长度统一使用定长编码
+func (p *Packet) Bytes() []byte {
+	content := p.Value
+	if p.IsConstructed() {
+		content = nil
+		for _, child := range p.Children {
+			content = append(content, child.Bytes()...)
+		}
+	}
+
+	out := []byte{p.Tag}
+	out = append(out, encodeLength(len(content))...)
+	return append(out, content...)
+}
+
+// Read 从流中读取一个完整的BER元素
+func Read(r *bufio.Reader) (*Packet, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if tag&0x1f == 0x1f {
+		return nil, fmt.Errorf("%w: 不支持多字节标签", ErrInvalid)
+	}
+
+	length, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+
+	content := make([]byte, length)
+	if _, err = io.ReadFull(r, content); err != nil {
+		return nil, err
+	}
+	return parse(tag, content)
+}
+
+// Decode 解析一个完整的BER元素，data中不能有多余的字节
+func Decode(data []byte) (*Packet, error) {
+	packet, rest, err := decodeOne(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: 元素之后有多余的字节", ErrInvalid)
+	}
+	return packet, nil
+}
+
+// decodeOne 从data开头解析一个BER元素，返回剩余的字节
+func decodeOne(data []byte) (*Packet, []byte, error) {
+	if len(data) < 2 {
+		return nil, nil, ErrInvalid
+	}
+	tag := data[0]
+	if tag&0x1f == 0x1f {
+		return nil, nil, fmt.Errorf("%w: 不支持多字节标签", ErrInvalid)
+	}
+
+	length, headerSize, err := decodeLength(data[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+	start := 1 + headerSize
+	if len(data)-start < length {
+		return nil, nil, fmt.Errorf("%w: 长度超出数据范围", ErrInvalid)
+	}
+
+	packet, err := parse(tag, data[start:start+length])
+	if err != nil {
+		return nil, nil, err
+	}
+	return packet, data[start+length:], nil
+}
+
+// parse 按标签解析内容，构造类型递归解析子元素
+func parse(tag byte, content []byte) (*Packet, error) {
+	packet := &Packet{Tag: tag}
+	if tag&Constructed == 0 {
+		packet.Value = content
+		return packet, nil
+	}
+
+	for len(content) > 0 {
+		child, rest, err := decodeOne(content)
+		if err != nil {
+			return nil, err
+		}
+		packet.Children = append(packet.Children, child)
+		content = rest
+	}
+	return packet, nil
+}
+
+// readLength 从流中读取长度字段，不支持不定长编码
+func readLength(r *bufio.Reader) (int, error) {
+	first, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if first&0x80 == 0 {
+		return int(first), nil
+	}
+
+	size := int(first & 0x7f)
+	if size == 0 || size > 4 {
+		return 0, fmt.Errorf("%w: 不支持的长度编码", ErrInvalid)
+	}
+	length := 0
+	for i := 0; i < size; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		length = length<<8 | int(b)
+	}
+	if length > MaxPacketSize {
+		return 0, fmt.Errorf("%w: 消息过长", ErrInvalid)
+	}
+	return length, nil
+}
+
+// decodeLength 解析长度字段，返回长度和长度字段占用的字节数
+func decodeLength(data []byte) (int, int, error) {
+	first := data[0]
+	if first&0x80 == 0 {
+		return int(first), 1, nil
+	}
+
+	size := int(first & 0x7f)
+	if size == 0 || size > 4 || len(data) < 1+size {
+		return 0, 0, fmt.Errorf("%w: 不支持的长度编码", ErrInvalid)
+	}
+	length := 0
+	for _, b := range data[1 : 1+size] {
+		length = length<<8 | int(b)
+	}
+	if length > MaxPacketSize {
+		return 0, 0, fmt.Errorf("%w: 消息过长", ErrInvalid)
+	}
+	return length, 1 + size, nil
+}
+
+// encodeLength 编码长度字段，小于128时使用短格式
+func encodeLength(length int) []byte {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+
+	var buf []byte
+	for length > 0 {
+		buf = append([]byte{byte(length)}, buf...)
+		length >>= 8
+	}
+	return append([]byte{0x80 | byte(len(buf))}, buf...)
+}
+
+// encodeInteger 以最短的二进制补码编码整数
+func encodeInteger(value int64) []byte {
+	buf := []byte{byte(value)}
+	for value > 127 || value < -128 {
+		value >>= 8
+		buf = append([]byte{byte(value)}, buf...)
+	}
+	return buf
+}
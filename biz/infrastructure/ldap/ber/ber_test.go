@@ -0,0 +1,126 @@
+package ber
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	// 简单绑定请求：messageID、[APPLICATION 0]{version, name, [0] password}
+	bindRequest := NewSequence(
+		NewInteger(0, 1),
+		NewConstructed(ClassApplication|0,
+			NewInteger(0, 3),
+			NewString(0, "uid=alice,ou=people,dc=example,dc=com"),
+			NewString(ClassContext|0, "secret"),
+		),
+	)
+	// 内容超过127字节时使用长格式长度
+	longValue := NewSequence(NewString(0, strings.Repeat("x", 300)), NewBoolean(true))
+
+	for _, packet := range []*Packet{bindRequest, longValue} {
+		encoded := packet.Bytes()
+		decoded, err := Decode(encoded)
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		if !bytes.Equal(decoded.Bytes(), encoded) {
+			t.Fatalf("重新编码结果不一致: %x != %x", decoded.Bytes(), encoded)
+		}
+
+		read, err := Read(bufio.NewReader(bytes.NewReader(encoded)))
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		if !bytes.Equal(read.Bytes(), encoded) {
+			t.Fatalf("Read结果与Decode不一致")
+		}
+	}
+
+	decoded, _ := Decode(bindRequest.Bytes())
+	op := decoded.Child(1)
+	if op.Tag != ClassApplication|Constructed || op.Child(1).String() != "uid=alice,ou=people,dc=example,dc=com" {
+		t.Fatalf("bind request = %+v", op)
+	}
+	if decoded.Child(2) != nil {
+		t.Fatal("越界的子元素应为nil")
+	}
+	if !longValue.Child(1).Bool() {
+		t.Fatal("BOOLEAN解析错误")
+	}
+}
+
+func TestIntegerEncoding(t *testing.T) {
+	tests := map[int64][]byte{
+		0:     {0x00},
+		127:   {0x7f},
+		128:   {0x00, 0x80},
+		256:   {0x01, 0x00},
+		-1:    {0xff},
+		-128:  {0x80},
+		-129:  {0xff, 0x7f},
+		65535: {0x00, 0xff, 0xff},
+	}
+	for value, want := range tests {
+		packet := NewInteger(0, value)
+		if !bytes.Equal(packet.Value, want) {
+			t.Errorf("encode %d = %x, want %x", value, packet.Value, want)
+		}
+		got, err := packet.Int()
+		if err != nil || got != value {
+			t.Errorf("decode %x = %d, %v, want %d", packet.Value, got, err, value)
+		}
+	}
+
+	if _, err := (&Packet{Tag: TagInteger}).Int(); !errors.Is(err, ErrInvalid) {
+		t.Errorf("空整数 err = %v, want ErrInvalid", err)
+	}
+	if _, err := (&Packet{Tag: TagInteger, Value: make([]byte, 9)}).Int(); !errors.Is(err, ErrInvalid) {
+		t.Errorf("超过8字节的整数 err = %v, want ErrInvalid", err)
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	tests := map[string][]byte{
+		"空数据":      {},
+		"只有标签":     {TagSequence},
+		"多字节标签":    {0x1f, 0x01, 0x00},
+		"长度超出数据":   {TagOctetString, 0x05, 'a', 'b'},
+		"不定长编码":    {TagSequence, 0x80, 0x00, 0x00},
+		"长度字段过长":   {TagOctetString, 0x85, 0x01, 0x00, 0x00, 0x00, 0x00},
+		"长度字段不完整":  {TagOctetString, 0x82, 0x01},
+		"消息过长":     {TagOctetString, 0x84, 0x7f, 0xff, 0xff, 0xff},
+		"元素后有多余字节": {TagNull, 0x00, 0x00},
+		"子元素越界":    {TagSequence, 0x03, TagOctetString, 0x05, 'a'},
+	}
+	for name, data := range tests {
+		if _, err := Decode(data); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: err = %v, want ErrInvalid", name, err)
+		}
+	}
+}
+
+func TestReadMalformed(t *testing.T) {
+	tests := map[string]struct {
+		data []byte
+		want error
+	}{
+		"多字节标签": {[]byte{0x1f, 0x01, 0x00}, ErrInvalid},
+		"不定长编码": {[]byte{TagSequence, 0x80}, ErrInvalid},
+		"消息过长":  {[]byte{TagOctetString, 0x84, 0x7f, 0xff, 0xff, 0xff}, ErrInvalid},
+		"内容被截断": {[]byte{TagOctetString, 0x05, 'a', 'b'}, io.ErrUnexpectedEOF},
+		"长度被截断": {[]byte{TagOctetString, 0x82, 0x01}, io.EOF},
+		"子元素无效": {[]byte{TagSequence, 0x02, 0x1f, 0x00}, ErrInvalid},
+		"连接已关闭": {nil, io.EOF},
+	}
+	for name, tt := range tests {
+		_, err := Read(bufio.NewReader(bytes.NewReader(tt.data)))
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package ldap
+
+import (
+	"auth/biz/infrastructure/ldap/ber"
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// LDAPv3协议操作标签（RFC 4511 4.2-4.5）
+const (
+	OpBindRequest           = ber.ClassApplication | ber.Constructed | 0
+	OpBindResponse          = ber.ClassApplication | ber.Constructed | 1
+	OpUnbindRequest         = ber.ClassApplication | 2
+	OpSearchRequest         = ber.ClassApplication | ber.Constructed | 3
+	OpSearchResultEntry     = ber.ClassApplication | ber.Constructed | 4
+	OpSearchResultDone      = ber.ClassApplication | ber.Constructed | 5
+	OpSearchResultReference = ber.ClassApplication | ber.Constructed | 19
+)
+
+// 绑定认证方式和搜索过滤器标签（RFC 4511 4.2、4.5.1.7）
+const (
+	AuthSimple     = ber.ClassContext | 0
+	FilterAnd      = ber.ClassContext | ber.Constructed | 0
+	FilterOr       = ber.ClassContext | ber.Constructed | 1
+	FilterNot      = ber.ClassContext | ber.Constructed | 2
+	FilterEquality = ber.ClassContext | ber.Constructed | 3
+	FilterPresent  = ber.ClassContext | 7
+)
+
+// 搜索范围和结果码
+const (
+	ScopeBaseObject          = 0
+	ScopeWholeSubtree        = 2
+	ResultSuccess            = 0
+	ResultNoSuchObject       = 32
+	ResultInvalidCredentials = 49
+	ResultUnwillingToPerform = 53
+	protocolVersion          = 3
+)
+
+// ResultError 目录返回的非成功结果
+type ResultError struct {
+	Code    int64
+	Message string
+}
+
+// Error 实现error接口
+func (e *ResultError) Error() string {
+	return fmt.Sprintf("ldap: 结果码%d %s", e.Code, e.Message)
+}
+
+// Entry 搜索返回的条目，属性名统一转换为小写
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Get 获取属性的全部值，属性名不区分大小写
+func (e *Entry) Get(name string) []string {
+	return e.Attributes[strings.ToLower(name)]
+}
+
+// First 获取属性的第一个值
+func (e *Entry) First(name string) string {
+	values := e.Get(name)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// EqualityFilter 属性等于指定值的过滤器，值按原样编码，不需要转义
+func EqualityFilter(attribute string, value string) *ber.Packet {
+	return ber.NewConstructed(FilterEquality, ber.NewString(0, attribute), ber.NewString(0, value))
+}
+
+// AndFilter 同时满足多个条件的过滤器
+func AndFilter(filters ...*ber.Packet) *ber.Packet {
+	return ber.NewConstructed(FilterAnd, filters...)
+}
+
+// conn LDAP连接，只实现登录需要的简单绑定和搜索
+type conn struct {
+	netConn   net.Conn
+	reader    *bufio.Reader
+	messageID int64
+}
+
+// dial 连接目录，ldaps使用TLS，整个连接的读写共用同一个超时时间
+func dial(ctx context.Context, rawURL string, timeout time.Duration) (*conn, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("目录地址无效: %w", err)
+	}
+
+	host := parsed.Host
+	dialer := &net.Dialer{Timeout: timeout}
+	var netConn net.Conn
+	switch parsed.Scheme {
+	case "ldap":
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), "389")
+		}
+		netConn, err = dialer.DialContext(ctx, "tcp", host)
+	case "ldaps":
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), "636")
+		}
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config:    &tls.Config{ServerName: parsed.Hostname(), MinVersion: tls.VersionTLS12},
+		}
+		netConn, err = tlsDialer.DialContext(ctx, "tcp", host)
+	default:
+		return nil, fmt.Errorf("不支持的目录地址协议: %s", parsed.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = netConn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	return &conn{netConn: netConn, reader: bufio.NewReader(netConn)}, nil
+}
+
+// close 发送UnbindRequest后关闭连接
+func (c *conn) close() {
+	_, _ = c.send(&ber.Packet{Tag: OpUnbindRequest})
+	_ = c.netConn.Close()
+}
+
+// bind 简单绑定，密码错误返回ErrInvalidCredentials
+// 空密码的简单绑定会被目录当作匿名绑定，调用方必须先拒绝空密码
+func (c *conn) bind(dn string, password string) error {
+	id, err := c.send(ber.NewConstructed(OpBindRequest,
+		ber.NewInteger(0, protocolVersion),
+		ber.NewString(0, dn),
+		ber.NewString(AuthSimple, password),
+	))
+	if err != nil {
+		return err
+	}
+
+	op, err := c.receive(id)
+	if err != nil {
+		return err
+	}
+	if op.Tag != OpBindResponse {
+		return fmt.Errorf("%w: 期望BindResponse", ber.ErrInvalid)
+	}
+
+	err = resultError(op)
+	var resultErr *ResultError
+	if errors.As(err, &resultErr) && resultErr.Code == ResultInvalidCredentials {
+		return ErrInvalidCredentials
+	}
+	return err
+}
+
+// search 在baseDN下搜索整个子树，返回的条目只包含指定的属性
+func (c *conn) search(baseDN string, filter *ber.Packet, attributes []string, sizeLimit int64) ([]*Entry, error) {
+	attributeList := ber.NewSequence()
+	for _, attribute := range attributes {
+		attributeList.Children = append(attributeList.Children, ber.NewString(0, attribute))
+	}
+
+	id, err := c.send(ber.NewConstructed(OpSearchRequest,
+		ber.NewString(0, baseDN),
+		ber.NewInteger(ber.TagEnumerated, ScopeWholeSubtree),
+		ber.NewInteger(ber.TagEnumerated, 0), // 不解引用别名
+		ber.NewInteger(0, sizeLimit),
+		ber.NewInteger(0, 0), // 不限制时间，由连接超时控制
+		ber.NewBoolean(false),
+		filter,
+		attributeList,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for {
+		op, err := c.receive(id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op.Tag {
+		case OpSearchResultEntry:
+			entry, err := parseEntry(op)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		case OpSearchResultReference:
+			// 不跟随引用，只在当前目录中查找
+		case OpSearchResultDone:
+			if err = resultError(op); err != nil {
+				return nil, err
+			}
+			return entries, nil
+		default:
+			return nil, fmt.Errorf("%w: 未知的搜索响应", ber.ErrInvalid)
+		}
+	}
+}
+
+// send 发送一条LDAPMessage，返回消息ID
+func (c *conn) send(op *ber.Packet) (int64, error) {
+	c.messageID++
+	message := ber.NewSequence(ber.NewInteger(0, c.messageID), op)
+	if _, err := c.netConn.Write(message.Bytes()); err != nil {
+		return 0, err
+	}
+	return c.messageID, nil
+}
+
+// receive 读取一条LDAPMessage，返回其中的协议操作
+func (c *conn) receive(id int64) (*ber.Packet, error) {
+	message, err := ber.Read(c.reader)
+	if err != nil {
+		return nil, err
+	}
+	if message.Tag != ber.TagSequence || len(message.Children) < 2 {
+		return nil, fmt.Errorf("%w: LDAPMessage格式错误", ber.ErrInvalid)
+	}
+
+	messageID, err := message.Child(0).Int()
+	if err != nil {
+		return nil, err
+	}
+	// 目录主动断开连接时发送消息ID为0的通知，同样按错误处理
+	if messageID != id {
+		return nil, fmt.Errorf("%w: 收到消息ID %d，期望%d", ber.ErrInvalid, messageID, id)
+	}
+	return message.Child(1), nil
+}
+
+// resultError 解析LDAPResult，成功时返回nil
+func resultError(op *ber.Packet) error {
+	if len(op.Children) < 3 {
+		return fmt.Errorf("%w: LDAPResult格式错误", ber.ErrInvalid)
+	}
+	code, err := op.Child(0).Int()
+	if err != nil {
+		return err
+	}
+	if code == ResultSuccess {
+		return nil
+	}
+	return &ResultError{Code: code, Message: op.Child(2).String()}
+}
+
+// parseEntry 解析SearchResultEntry
+func parseEntry(op *ber.Packet) (*Entry, error) {
+	if len(op.Children) < 2 {
+		return nil, fmt.Errorf("%w: SearchResultEntry格式错误", ber.ErrInvalid)
+	}
+
+	entry := &Entry{DN: op.Child(0).String(), Attributes: make(map[string][]string)}
+	for _, attribute := range op.Child(1).Children {
+		if len(attribute.Children) < 2 {
+			return nil, fmt.Errorf("%w: 属性格式错误", ber.ErrInvalid)
+		}
+		name := strings.ToLower(attribute.Child(0).String())
+		for _, value := range attribute.Child(1).Children {
+			entry.Attributes[name] = append(entry.Attributes[name], value.String())
+		}
+	}
+	return entry, nil
+}
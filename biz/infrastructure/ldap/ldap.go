@@ -0,0 +1,152 @@
+package ldap
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidCredentials 密码错误
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+	// ErrUserNotFound 目录中没有该邮箱对应的用户，或对应多个用户
+	ErrUserNotFound = errors.New("ldap: user not found")
+)
+
+// Account 通过目录校验密码的用户
+type Account struct {
+	DN     string
+	Email  string
+	Groups []string // 所属组的DN
+	Role   string   // 按GroupRoles映射得到的用户角色
+}
+
+// Enabled 是否配置了LDAP目录
+func Enabled() bool {
+	return config.GetConfig().LDAP.URL != ""
+}
+
+// ManagesEmail 该邮箱的域名是否由目录管理
+func ManagesEmail(userEmail string) bool {
+	ldapConfig := config.GetConfig().LDAP
+	if ldapConfig.URL == "" {
+		return false
+	}
+
+	index := strings.LastIndex(userEmail, "@")
+	if index < 0 {
+		return false
+	}
+	domain := userEmail[index+1:]
+	for _, managed := range ldapConfig.Domains {
+		if strings.EqualFold(domain, managed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate 按邮箱在目录中搜索用户，再以用户DN和密码简单绑定校验密码
+// 密码错误返回ErrInvalidCredentials，目录中没有该用户返回ErrUserNotFound，其他错误表示目录不可用或配置有误
+func Authenticate(ctx context.Context, userEmail string, password string) (*Account, error) {
+	// 空密码的简单绑定在多数目录中是匿名绑定，会直接成功
+	if password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	ldapConfig := config.GetConfig().LDAP
+	if ldapConfig.URL == "" {
+		return nil, errors.New("未配置LDAP目录")
+	}
+
+	timeout := time.Duration(ldapConfig.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	c, err := dial(ctx, ldapConfig.URL, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("连接LDAP目录失败: %w", err)
+	}
+	defer c.close()
+
+	// 使用服务账号搜索用户，服务账号密码错误属于配置问题，不能当作用户密码错误
+	if ldapConfig.BindDN != "" {
+		if err = c.bind(ldapConfig.BindDN, ldapConfig.BindPassword); err != nil {
+			return nil, fmt.Errorf("LDAP服务账号绑定失败: %v", err)
+		}
+	}
+
+	emailAttribute := valueOrDefault(ldapConfig.EmailAttribute, "mail")
+	groupAttribute := valueOrDefault(ldapConfig.GroupAttribute, "memberOf")
+	filter := AndFilter(
+		EqualityFilter("objectClass", valueOrDefault(ldapConfig.UserObjectClass, "person")),
+		EqualityFilter(emailAttribute, userEmail),
+	)
+	entries, err := c.search(ldapConfig.BaseDN, filter, []string{emailAttribute, groupAttribute}, 2)
+	if err != nil {
+		var resultErr *ResultError
+		if errors.As(err, &resultErr) && resultErr.Code == ResultNoSuchObject {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("搜索LDAP用户失败: %w", err)
+	}
+
+	// 同一邮箱对应多个条目时无法确定是哪个用户
+	if len(entries) != 1 {
+		if len(entries) > 1 {
+			fmt.Println("LDAP目录中有多个用户使用同一邮箱:", userEmail)
+		}
+		return nil, ErrUserNotFound
+	}
+
+	entry := entries[0]
+	if err = c.bind(entry.DN, password); err != nil {
+		return nil, err
+	}
+
+	groups := entry.Get(groupAttribute)
+	return &Account{
+		DN:     entry.DN,
+		Email:  userEmail,
+		Groups: groups,
+		Role:   roleForGroups(ldapConfig.GroupRoles, groups),
+	}, nil
+}
+
+// roleForGroups 按组映射用户角色，匹配多个时admin优先，都不匹配时为普通用户
+func roleForGroups(groupRoles map[string]string, groups []string) string {
+	role := consts.RoleUser
+	for _, group := range groups {
+		for groupDN, mapped := range groupRoles {
+			if !strings.EqualFold(normalizeDN(group), normalizeDN(groupDN)) {
+				continue
+			}
+			if mapped == consts.RoleAdmin {
+				return consts.RoleAdmin
+			}
+			role = mapped
+		}
+	}
+	return role
+}
+
+// normalizeDN 去掉DN各部分前后的空格，便于比较
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+	return strings.Join(parts, ",")
+}
+
+// valueOrDefault 未配置时使用默认值
+func valueOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,172 @@
+package ldap_test
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/ldap"
+	"auth/biz/infrastructure/ldap/mockdirectory"
+	"context"
+	"errors"
+	"testing"
+)
+
+const (
+	testBaseDN       = "ou=people,dc=example,dc=com"
+	testBindDN       = "cn=search,dc=example,dc=com"
+	testBindPassword = "search-secret"
+	testAdminGroup   = "cn=admins,ou=groups,dc=example,dc=com"
+	testStaffGroup   = "cn=staff,ou=groups,dc=example,dc=com"
+)
+
+// person 创建目录中的用户条目
+func person(uid string, email string, password string, groups ...string) mockdirectory.Entry {
+	return mockdirectory.Entry{
+		DN:       "uid=" + uid + "," + testBaseDN,
+		Password: password,
+		Attributes: map[string][]string{
+			"objectClass": {"top", "person"},
+			"uid":         {uid},
+			"mail":        {email},
+			"memberOf":    groups,
+		},
+	}
+}
+
+// newDirectory 启动模拟目录并让全局配置指向它，测试结束时恢复配置
+func newDirectory(t *testing.T, entries ...mockdirectory.Entry) *mockdirectory.Server {
+	t.Helper()
+	entries = append(entries, mockdirectory.Entry{DN: testBindDN, Password: testBindPassword})
+	server, err := mockdirectory.NewServer(entries...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := config.GetConfig()
+	previous := conf.LDAP
+	conf.LDAP = server.LDAPConfig(testBindDN, testBindPassword, testBaseDN, "example.com")
+	conf.LDAP.GroupRoles = map[string]string{
+		testAdminGroup: consts.RoleAdmin,
+		testStaffGroup: consts.RoleUser,
+	}
+	t.Cleanup(func() {
+		conf.LDAP = previous
+		_ = server.Close()
+	})
+	return server
+}
+
+func TestAuthenticateSearchThenBind(t *testing.T) {
+	newDirectory(t, person("alice", "alice@example.com", "alice-password", testStaffGroup))
+
+	account, err := ldap.Authenticate(context.Background(), "alice@example.com", "alice-password")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if account.DN != "uid=alice,"+testBaseDN || account.Email != "alice@example.com" {
+		t.Fatalf("account = %+v", account)
+	}
+	if len(account.Groups) != 1 || account.Groups[0] != testStaffGroup {
+		t.Fatalf("groups = %v", account.Groups)
+	}
+}
+
+func TestAuthenticateWrongPassword(t *testing.T) {
+	newDirectory(t, person("alice", "alice@example.com", "alice-password"))
+
+	_, err := ldap.Authenticate(context.Background(), "alice@example.com", "wrong-password")
+	if !errors.Is(err, ldap.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+
+	// 空密码在目录中是匿名绑定，不能当作校验成功
+	_, err = ldap.Authenticate(context.Background(), "alice@example.com", "")
+	if !errors.Is(err, ldap.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthenticateMissingUser(t *testing.T) {
+	newDirectory(t, person("alice", "alice@example.com", "alice-password"))
+
+	_, err := ldap.Authenticate(context.Background(), "nobody@example.com", "alice-password")
+	if !errors.Is(err, ldap.ErrUserNotFound) {
+		t.Fatalf("err = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestAuthenticateDuplicateEntries(t *testing.T) {
+	newDirectory(t,
+		person("alice", "shared@example.com", "alice-password"),
+		person("bob", "shared@example.com", "bob-password"),
+	)
+
+	// 同一邮箱对应多个条目时无法确定是哪个用户，任何一个的密码都不能通过
+	for _, password := range []string{"alice-password", "bob-password"} {
+		_, err := ldap.Authenticate(context.Background(), "shared@example.com", password)
+		if !errors.Is(err, ldap.ErrUserNotFound) {
+			t.Fatalf("err = %v, want ErrUserNotFound", err)
+		}
+	}
+}
+
+func TestAuthenticateServiceAccountFailure(t *testing.T) {
+	newDirectory(t, person("alice", "alice@example.com", "alice-password"))
+	config.GetConfig().LDAP.BindPassword = "wrong-secret"
+
+	// 服务账号密码错误属于配置问题，不能当作用户密码错误计入失败次数
+	_, err := ldap.Authenticate(context.Background(), "alice@example.com", "alice-password")
+	if err == nil || errors.Is(err, ldap.ErrInvalidCredentials) || errors.Is(err, ldap.ErrUserNotFound) {
+		t.Fatalf("err = %v, want directory error", err)
+	}
+}
+
+func TestAuthenticateGroupRoles(t *testing.T) {
+	newDirectory(t,
+		person("admin", "admin@example.com", "admin-password", testStaffGroup, "CN=admins, OU=groups, DC=example, DC=com"),
+		person("staff", "staff@example.com", "staff-password", testStaffGroup),
+		person("guest", "guest@example.com", "guest-password", "cn=guests,ou=groups,dc=example,dc=com"),
+	)
+
+	tests := []struct {
+		email    string
+		password string
+		role     string
+	}{
+		// 组DN不区分大小写和空格，匹配多个时admin优先
+		{"admin@example.com", "admin-password", consts.RoleAdmin},
+		{"staff@example.com", "staff-password", consts.RoleUser},
+		// 不匹配任何组时为普通用户
+		{"guest@example.com", "guest-password", consts.RoleUser},
+	}
+	for _, tt := range tests {
+		account, err := ldap.Authenticate(context.Background(), tt.email, tt.password)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.email, err)
+		}
+		if account.Role != tt.role {
+			t.Errorf("%s: role = %q, want %q", tt.email, account.Role, tt.role)
+		}
+	}
+}
+
+func TestManagesEmail(t *testing.T) {
+	newDirectory(t)
+
+	tests := map[string]bool{
+		"alice@example.com":     true,
+		"Alice@EXAMPLE.com":     true,
+		"alice@sub.example.com": false,
+		"alice@other.com":       false,
+		"not-an-email":          false,
+	}
+	for email, want := range tests {
+		if got := ldap.ManagesEmail(email); got != want {
+			t.Errorf("ManagesEmail(%q) = %v, want %v", email, got, want)
+		}
+	}
+
+	config.GetConfig().LDAP.URL = ""
+	if ldap.ManagesEmail("alice@example.com") {
+		t.Error("未启用目录时不应管理任何邮箱")
+	}
+}
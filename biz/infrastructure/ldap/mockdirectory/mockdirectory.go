@@ -0,0 +1,292 @@
+package mockdirectory
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/ldap"
+	"auth/biz/infrastructure/ldap/ber"
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Entry 模拟目录中的条目
+type Entry struct {
+	DN         string
+	Password   string              // 简单绑定使用的密码，为空时该条目不能绑定
+	Attributes map[string][]string // 属性名不区分大小写
+}
+
+// Server 模拟的LDAP目录，监听本机端口，用于本地开发和测试，不需要真实的目录服务
+// 只支持简单绑定、整个子树的搜索（and、or、not、等值和存在过滤器）和解绑
+type Server struct {
+	URL string // 目录地址，如ldap://127.0.0.1:38911
+
+	listener net.Listener
+	mu       sync.Mutex
+	entries  []Entry
+	conns    map[net.Conn]struct{}
+	closed   bool
+}
+
+// NewServer 在本机随机端口启动模拟目录
+func NewServer(entries ...Entry) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		URL:      "ldap://" + listener.Addr().String(),
+		listener: listener,
+		entries:  entries,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	go s.serve()
+	return s, nil
+}
+
+// Close 停止模拟目录并断开所有连接
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for c := range s.conns {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+	return s.listener.Close()
+}
+
+// AddEntry 添加条目
+func (s *Server) AddEntry(entry Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+}
+
+// LDAPConfig 指向模拟目录的LDAP配置
+func (s *Server) LDAPConfig(bindDN, bindPassword, baseDN string, domains ...string) config.LDAPConfig {
+	return config.LDAPConfig{
+		URL:             s.URL,
+		BindDN:          bindDN,
+		BindPassword:    bindPassword,
+		BaseDN:          baseDN,
+		UserObjectClass: "person",
+		EmailAttribute:  "mail",
+		GroupAttribute:  "memberOf",
+		Domains:         domains,
+		Timeout:         5,
+	}
+}
+
+// serve 接受连接
+func (s *Server) serve() {
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				fmt.Println("模拟LDAP目录已停止:", err)
+			}
+			return
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = c.Close()
+			return
+		}
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+
+		go s.handle(c)
+	}
+}
+
+// handle 处理一个连接上的请求，直到客户端解绑或断开
+func (s *Server) handle(c net.Conn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		_ = c.Close()
+	}()
+
+	reader := bufio.NewReader(c)
+	for {
+		message, err := ber.Read(reader)
+		if err != nil || len(message.Children) < 2 {
+			return
+		}
+		messageID, err := message.Child(0).Int()
+		if err != nil {
+			return
+		}
+
+		op := message.Child(1)
+		var responses []*ber.Packet
+		switch op.Tag {
+		case ldap.OpBindRequest:
+			responses = []*ber.Packet{s.bind(op)}
+		case ldap.OpSearchRequest:
+			responses = s.search(op)
+		default:
+			// UnbindRequest和不支持的操作都直接断开连接
+			return
+		}
+
+		for _, response := range responses {
+			packet := ber.NewSequence(ber.NewInteger(0, messageID), response)
+			if _, err = c.Write(packet.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind 处理简单绑定，DN和密码都为空时视为匿名绑定
+func (s *Server) bind(op *ber.Packet) *ber.Packet {
+	if len(op.Children) < 3 || op.Child(2).Tag != ldap.AuthSimple {
+		return result(ldap.OpBindResponse, ldap.ResultUnwillingToPerform, "只支持简单绑定")
+	}
+
+	dn, password := op.Child(1).String(), op.Child(2).String()
+	if dn == "" && password == "" {
+		return result(ldap.OpBindResponse, ldap.ResultSuccess, "")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, entry := range s.entries {
+		if strings.EqualFold(entry.DN, dn) && entry.Password != "" && entry.Password == password {
+			return result(ldap.OpBindResponse, ldap.ResultSuccess, "")
+		}
+	}
+	return result(ldap.OpBindResponse, ldap.ResultInvalidCredentials, "")
+}
+
+// search 处理搜索，返回匹配的条目和SearchResultDone
+func (s *Server) search(op *ber.Packet) []*ber.Packet {
+	if len(op.Children) < 8 {
+		return []*ber.Packet{result(ldap.OpSearchResultDone, ldap.ResultUnwillingToPerform, "搜索请求格式错误")}
+	}
+
+	baseDN := strings.ToLower(op.Child(0).String())
+	sizeLimit, _ := op.Child(3).Int()
+	filter := op.Child(6)
+	var requested []string
+	for _, attribute := range op.Child(7).Children {
+		requested = append(requested, attribute.String())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	baseFound := baseDN == ""
+	var responses []*ber.Packet
+	for _, entry := range s.entries {
+		dn := strings.ToLower(entry.DN)
+		if dn == baseDN {
+			baseFound = true
+		}
+		if baseDN != "" && dn != baseDN && !strings.HasSuffix(dn, ","+baseDN) {
+			continue
+		}
+		if !matches(entry, filter) {
+			continue
+		}
+		if sizeLimit > 0 && int64(len(responses)) >= sizeLimit {
+			responses = append(responses, result(ldap.OpSearchResultDone, 4, "超出数量限制"))
+			return responses
+		}
+		responses = append(responses, toSearchEntry(entry, requested))
+	}
+
+	if !baseFound && len(responses) == 0 {
+		return []*ber.Packet{result(ldap.OpSearchResultDone, ldap.ResultNoSuchObject, "")}
+	}
+	return append(responses, result(ldap.OpSearchResultDone, ldap.ResultSuccess, ""))
+}
+
+// matches 条目是否满足过滤器，属性值比较不区分大小写
+func matches(entry Entry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matches(entry, child) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if matches(entry, child) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return len(filter.Children) == 1 && !matches(entry, filter.Child(0))
+	case ldap.FilterEquality:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		for _, value := range attributeValues(entry, filter.Child(0).String()) {
+			if strings.EqualFold(value, filter.Child(1).String()) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		return len(attributeValues(entry, filter.String())) > 0
+	default:
+		return false
+	}
+}
+
+// attributeValues 获取条目的属性值，属性名不区分大小写
+func attributeValues(entry Entry, name string) []string {
+	for key, values := range entry.Attributes {
+		if strings.EqualFold(key, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+// toSearchEntry 构造SearchResultEntry，requested为空时返回全部属性
+func toSearchEntry(entry Entry, requested []string) *ber.Packet {
+	attributes := ber.NewSequence()
+	for key, values := range entry.Attributes {
+		if len(requested) > 0 && !containsFold(requested, key) {
+			continue
+		}
+		set := &ber.Packet{Tag: ber.TagSet}
+		for _, value := range values {
+			set.Children = append(set.Children, ber.NewString(0, value))
+		}
+		attributes.Children = append(attributes.Children, ber.NewSequence(ber.NewString(0, key), set))
+	}
+	return ber.NewConstructed(ldap.OpSearchResultEntry, ber.NewString(0, entry.DN), attributes)
+}
+
+// result 构造LDAPResult响应
+func result(tag byte, code int64, message string) *ber.Packet {
+	return ber.NewConstructed(tag,
+		ber.NewInteger(ber.TagEnumerated, code),
+		ber.NewString(0, ""),
+		ber.NewString(0, message),
+	)
+}
+
+// containsFold 列表中是否包含指定字符串，不区分大小写
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Client 已注册的OAuth客户端
+type Client struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ClientID     string             `bson:"client_id" json:"clientId"`
+	Name         string             `bson:"name" json:"name"`
+	Type         string             `bson:"type" json:"type"`                      // 客户端类型：public-公开客户端，confidential-机密客户端
+	SecretHash   string             `bson:"secret_hash,omitempty" json:"-"`        // client_secret的bcrypt摘要，公开客户端为空
+	RedirectURIs []string           `bson:"redirect_uris" json:"redirectUris"`     // 允许的回调地址，授权时要求完全匹配
+	Scopes       []string           `bson:"scopes" json:"scopes"`                  // 允许申请的授权范围
+	CreatorID    primitive.ObjectID `bson:"creator_id,omitempty" json:"creatorId"` // 注册该客户端的管理员
+	CreateTime   time.Time          `bson:"create_time,omitempty" json:"createTime"`
+	UpdateTime   time.Time          `bson:"update_time,omitempty" json:"updateTime"`
+}
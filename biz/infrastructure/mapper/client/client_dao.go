@@ -0,0 +1,128 @@
+package client
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IClientDAO OAuth客户端数据访问接口
+type IClientDAO interface {
+	// Create 创建客户端
+	Create(ctx context.Context, client *Client) error
+	// FindByClientID 通过client_id查找客户端
+	FindByClientID(ctx context.Context, clientID string) (*Client, error)
+	// FindAll 查找所有客户端
+	FindAll(ctx context.Context) ([]*Client, error)
+	// Delete 删除客户端
+	Delete(ctx context.Context, clientID string) (bool, error)
+}
+
+// ClientDAO MongoDB实现的客户端DAO
+type ClientDAO struct{}
+
+// 确保ClientDAO实现了IClientDAO接口
+var _ IClientDAO = (*ClientDAO)(nil)
+
+// NewClientDAO 创建客户端DAO实例
+func NewClientDAO() IClientDAO {
+	return &ClientDAO{}
+}
+
+// 获取客户端集合
+func (d *ClientDAO) getCollection() (*mongo.Collection, error) {
+	return util.GetCollection(consts.ClientCollection)
+}
+
+// Create 创建客户端
+func (d *ClientDAO) Create(ctx context.Context, client *Client) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	if client.ID.IsZero() {
+		client.ID = primitive.NewObjectID()
+	}
+
+	// 设置创建时间
+	now := time.Now()
+	client.CreateTime = now
+	client.UpdateTime = now
+
+	// 插入数据
+	_, err = collection.InsertOne(ctx, client)
+	return err
+}
+
+// FindByClientID 通过client_id查找客户端
+func (d *ClientDAO) FindByClientID(ctx context.Context, clientID string) (*Client, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 执行查询
+	var client Client
+	err = collection.FindOne(ctx, bson.M{"client_id": clientID}).Decode(&client)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 客户端不存在
+		}
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+// FindAll 查找所有客户端，按创建时间倒序
+func (d *ClientDAO) FindAll(ctx context.Context) ([]*Client, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.M{"create_time": -1})
+
+	// 执行查询
+	cursor, err := collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 解析结果
+	var clients []*Client
+	err = cursor.All(ctx, &clients)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+// Delete 删除客户端，返回客户端是否存在
+func (d *ClientDAO) Delete(ctx context.Context, clientID string) (bool, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return false, err
+	}
+
+	// 执行删除
+	result, err := collection.DeleteOne(ctx, bson.M{"client_id": clientID})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
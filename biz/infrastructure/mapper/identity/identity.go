@@ -0,0 +1,17 @@
+package identity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Identity 用户的登录标识，一个用户可以有多个邮箱或手机号，(Type, Identifier)全局唯一
+type Identity struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID     primitive.ObjectID `bson:"user_id" json:"userId"`
+	Type       string             `bson:"type" json:"type"`             // 标识类型：email-邮箱，phone-手机号，social:服务商名称-第三方账号
+	Identifier string             `bson:"identifier" json:"identifier"` // 邮箱原文、E.164格式的手机号或第三方账号ID
+	Verified   bool               `bson:"verified" json:"verified"`     // 是否已通过验证码确认归属，只有已验证的标识可以用于登录
+	CreateTime time.Time          `bson:"create_time,omitempty" json:"createTime"`
+}
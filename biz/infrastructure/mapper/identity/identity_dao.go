@@ -0,0 +1,166 @@
+package identity
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrDuplicate 登录标识已被其他记录使用，由(type, identifier)唯一索引保证
+var ErrDuplicate = errors.New("登录标识已被使用")
+
+// IIdentityDAO 登录标识数据访问接口
+type IIdentityDAO interface {
+	// Create 创建登录标识，标识已存在时返回ErrDuplicate
+	Create(ctx context.Context, identity *Identity) error
+	// FindByIdentifier 通过类型和标识查找登录标识
+	FindByIdentifier(ctx context.Context, identityType string, identifier string) (*Identity, error)
+	// FindByUserID 查找用户的所有登录标识
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Identity, error)
+	// Delete 删除用户的指定登录标识，返回是否删除成功
+	Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) (bool, error)
+}
+
+// IdentityDAO MongoDB实现的登录标识DAO
+type IdentityDAO struct{}
+
+// 确保IdentityDAO实现了IIdentityDAO接口
+var _ IIdentityDAO = (*IdentityDAO)(nil)
+
+// indexOnce 首次获取集合时创建索引
+var indexOnce sync.Once
+
+// NewIdentityDAO 创建登录标识DAO实例
+func NewIdentityDAO() IIdentityDAO {
+	return &IdentityDAO{}
+}
+
+// 获取登录标识集合，首次获取时创建索引
+func (d *IdentityDAO) getCollection() (*mongo.Collection, error) {
+	collection, err := util.GetCollection(consts.CredentialCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	indexOnce.Do(func() {
+		ensureIndexes(collection)
+	})
+	return collection, nil
+}
+
+// ensureIndexes 创建(type, identifier)唯一索引和user_id索引，索引已存在时不做修改
+// 创建失败只记录日志，不影响查询；唯一索引缺失时并发创建同一标识可能产生重复记录
+func ensureIndexes(collection *mongo.Collection) {
+	ctx, cancel := util.CreateContext()
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "identifier", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		fmt.Println("创建登录标识索引失败:", err)
+	}
+}
+
+// Create 创建登录标识
+func (d *IdentityDAO) Create(ctx context.Context, identity *Identity) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	// 生成ID
+	if identity.ID.IsZero() {
+		identity.ID = primitive.NewObjectID()
+	}
+
+	// 设置创建时间
+	identity.CreateTime = time.Now()
+
+	// 插入数据
+	_, err = collection.InsertOne(ctx, identity)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrDuplicate
+	}
+	return err
+}
+
+// FindByIdentifier 通过类型和标识查找登录标识
+func (d *IdentityDAO) FindByIdentifier(ctx context.Context, identityType string, identifier string) (*Identity, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 执行查询
+	var identity Identity
+	err = collection.FindOne(ctx, bson.M{"type": identityType, "identifier": identifier}).Decode(&identity)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 登录标识不存在
+		}
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+// FindByUserID 查找用户的所有登录标识，按创建时间正序
+func (d *IdentityDAO) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Identity, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.M{"create_time": 1})
+
+	// 执行查询
+	cursor, err := collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 解析结果
+	var identities []*Identity
+	err = cursor.All(ctx, &identities)
+	if err != nil {
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+// Delete 删除用户的指定登录标识，按用户ID过滤，不能删除其他用户的登录标识
+func (d *IdentityDAO) Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) (bool, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
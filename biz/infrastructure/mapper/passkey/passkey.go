@@ -0,0 +1,25 @@
+package passkey
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Passkey 用户注册的通行密钥（WebAuthn凭证），只保存公钥
+type Passkey struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID          primitive.ObjectID `bson:"user_id" json:"userId"`
+	CredentialID    []byte             `bson:"credential_id" json:"-"`                 // 验证器生成的凭证ID，全局唯一
+	PublicKey       []byte             `bson:"public_key" json:"-"`                    // COSE格式公钥
+	AttestationType string             `bson:"attestation_type" json:"-"`              // 注册时的证明格式，只接受none
+	Transports      []string           `bson:"transports,omitempty" json:"transports"` // 验证器支持的传输方式：internal、usb、hybrid等
+	AAGUID          []byte             `bson:"aaguid,omitempty" json:"-"`              // 验证器型号标识
+	SignCount       uint32             `bson:"sign_count" json:"-"`                    // 最近一次使用时的签名计数
+	UserVerified    bool               `bson:"user_verified" json:"-"`                 // 最近一次使用时是否完成了用户验证（指纹、PIN等）
+	BackupEligible  bool               `bson:"backup_eligible" json:"backupEligible"`  // 是否可同步备份
+	BackupState     bool               `bson:"backup_state" json:"backupState"`        // 是否已同步备份
+	Name            string             `bson:"name" json:"name"`                       // 用户设置的名称
+	CreateTime      time.Time          `bson:"create_time,omitempty" json:"createTime"`
+	LastUsedTime    time.Time          `bson:"last_used_time,omitempty" json:"lastUsedTime"`
+}
@@ -0,0 +1,161 @@
+package passkey
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IPasskeyDAO 通行密钥数据访问接口
+type IPasskeyDAO interface {
+	// Create 创建通行密钥
+	Create(ctx context.Context, passkey *Passkey) error
+	// FindByUserID 查找用户的所有通行密钥
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Passkey, error)
+	// FindByCredentialID 通过凭证ID查找通行密钥
+	FindByCredentialID(ctx context.Context, credentialID []byte) (*Passkey, error)
+	// CountByUserID 统计用户的通行密钥数量
+	CountByUserID(ctx context.Context, userID primitive.ObjectID) (int64, error)
+	// UpdateUsage 登录成功后更新签名计数、认证器标志和最近使用时间
+	UpdateUsage(ctx context.Context, passkey *Passkey) error
+	// Delete 删除用户的指定通行密钥，返回是否删除成功
+	Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) (bool, error)
+}
+
+// PasskeyDAO MongoDB实现的通行密钥DAO
+type PasskeyDAO struct{}
+
+// 确保PasskeyDAO实现了IPasskeyDAO接口
+var _ IPasskeyDAO = (*PasskeyDAO)(nil)
+
+// NewPasskeyDAO 创建通行密钥DAO实例
+func NewPasskeyDAO() IPasskeyDAO {
+	return &PasskeyDAO{}
+}
+
+// 获取通行密钥集合
+func (d *PasskeyDAO) getCollection() (*mongo.Collection, error) {
+	return util.GetCollection(consts.PasskeyCollection)
+}
+
+// Create 创建通行密钥
+func (d *PasskeyDAO) Create(ctx context.Context, passkey *Passkey) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	// 生成ID
+	if passkey.ID.IsZero() {
+		passkey.ID = primitive.NewObjectID()
+	}
+
+	// 设置创建时间
+	passkey.CreateTime = time.Now()
+
+	// 插入数据
+	_, err = collection.InsertOne(ctx, passkey)
+	return err
+}
+
+// FindByUserID 查找用户的所有通行密钥，按创建时间正序
+func (d *PasskeyDAO) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Passkey, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.M{"create_time": 1})
+
+	// 执行查询
+	cursor, err := collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 解析结果
+	var passkeys []*Passkey
+	err = cursor.All(ctx, &passkeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return passkeys, nil
+}
+
+// FindByCredentialID 通过凭证ID查找通行密钥
+func (d *PasskeyDAO) FindByCredentialID(ctx context.Context, credentialID []byte) (*Passkey, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 执行查询
+	var passkey Passkey
+	err = collection.FindOne(ctx, bson.M{"credential_id": credentialID}).Decode(&passkey)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 通行密钥不存在
+		}
+		return nil, err
+	}
+
+	return &passkey, nil
+}
+
+// CountByUserID 统计用户的通行密钥数量
+func (d *PasskeyDAO) CountByUserID(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return 0, err
+	}
+
+	return collection.CountDocuments(ctx, bson.M{"user_id": userID})
+}
+
+// UpdateUsage 登录成功后更新签名计数、认证器标志和最近使用时间
+func (d *PasskeyDAO) UpdateUsage(ctx context.Context, passkey *Passkey) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	passkey.LastUsedTime = time.Now()
+	update := bson.M{"$set": bson.M{
+		"sign_count":     passkey.SignCount,
+		"user_verified":  passkey.UserVerified,
+		"backup_state":   passkey.BackupState,
+		"last_used_time": passkey.LastUsedTime,
+	}}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": passkey.ID}, update)
+	return err
+}
+
+// Delete 删除用户的指定通行密钥，按用户ID过滤，不能删除其他用户的通行密钥
+func (d *PasskeyDAO) Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) (bool, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
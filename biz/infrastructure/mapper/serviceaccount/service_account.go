@@ -0,0 +1,21 @@
+package serviceaccount
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ServiceAccount 服务账号，供后台任务等非人类调用方通过client_credentials获取token
+type ServiceAccount struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ClientID         string             `bson:"client_id" json:"clientId"`
+	Name             string             `bson:"name" json:"name"`
+	SecretHash       string             `bson:"secret_hash" json:"-"`     // client_secret的bcrypt摘要
+	Scopes           []string           `bson:"scopes" json:"scopes"`     // 允许申请的授权范围
+	Disabled         bool               `bson:"disabled" json:"disabled"` // 停用后无法再获取token，已签发的token同时被吊销
+	CreatorID        primitive.ObjectID `bson:"creator_id,omitempty" json:"creatorId"`
+	SecretRotateTime time.Time          `bson:"secret_rotate_time,omitempty" json:"secretRotateTime"` // 最近一次轮换密钥的时间
+	CreateTime       time.Time          `bson:"create_time,omitempty" json:"createTime"`
+	UpdateTime       time.Time          `bson:"update_time,omitempty" json:"updateTime"`
+}
@@ -0,0 +1,148 @@
+package serviceaccount
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IServiceAccountDAO 服务账号数据访问接口
+type IServiceAccountDAO interface {
+	// Create 创建服务账号
+	Create(ctx context.Context, account *ServiceAccount) error
+	// FindByClientID 通过client_id查找服务账号
+	FindByClientID(ctx context.Context, clientID string) (*ServiceAccount, error)
+	// FindAll 查找所有服务账号
+	FindAll(ctx context.Context) ([]*ServiceAccount, error)
+	// UpdateSecret 更新密钥摘要，返回服务账号是否存在
+	UpdateSecret(ctx context.Context, clientID string, secretHash string) (bool, error)
+	// SetDisabled 启用或停用服务账号，返回服务账号是否存在
+	SetDisabled(ctx context.Context, clientID string, disabled bool) (bool, error)
+}
+
+// ServiceAccountDAO MongoDB实现的服务账号DAO
+type ServiceAccountDAO struct{}
+
+// 确保ServiceAccountDAO实现了IServiceAccountDAO接口
+var _ IServiceAccountDAO = (*ServiceAccountDAO)(nil)
+
+// NewServiceAccountDAO 创建服务账号DAO实例
+func NewServiceAccountDAO() IServiceAccountDAO {
+	return &ServiceAccountDAO{}
+}
+
+// 获取服务账号集合
+func (d *ServiceAccountDAO) getCollection() (*mongo.Collection, error) {
+	return util.GetCollection(consts.ServiceAccountCollection)
+}
+
+// Create 创建服务账号
+func (d *ServiceAccountDAO) Create(ctx context.Context, account *ServiceAccount) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	if account.ID.IsZero() {
+		account.ID = primitive.NewObjectID()
+	}
+
+	// 设置创建时间
+	now := time.Now()
+	account.CreateTime = now
+	account.UpdateTime = now
+	account.SecretRotateTime = now
+
+	// 插入数据
+	_, err = collection.InsertOne(ctx, account)
+	return err
+}
+
+// FindByClientID 通过client_id查找服务账号
+func (d *ServiceAccountDAO) FindByClientID(ctx context.Context, clientID string) (*ServiceAccount, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 执行查询
+	var account ServiceAccount
+	err = collection.FindOne(ctx, bson.M{"client_id": clientID}).Decode(&account)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 服务账号不存在
+		}
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// FindAll 查找所有服务账号，按创建时间倒序
+func (d *ServiceAccountDAO) FindAll(ctx context.Context) ([]*ServiceAccount, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.M{"create_time": -1})
+
+	// 执行查询
+	cursor, err := collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 解析结果
+	var accounts []*ServiceAccount
+	err = cursor.All(ctx, &accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// UpdateSecret 更新密钥摘要，返回服务账号是否存在
+func (d *ServiceAccountDAO) UpdateSecret(ctx context.Context, clientID string, secretHash string) (bool, error) {
+	now := time.Now()
+	return d.update(ctx, clientID, bson.M{
+		"secret_hash":        secretHash,
+		"secret_rotate_time": now,
+		"update_time":        now,
+	})
+}
+
+// SetDisabled 启用或停用服务账号，返回服务账号是否存在
+func (d *ServiceAccountDAO) SetDisabled(ctx context.Context, clientID string, disabled bool) (bool, error) {
+	return d.update(ctx, clientID, bson.M{
+		"disabled":    disabled,
+		"update_time": time.Now(),
+	})
+}
+
+// update 按client_id更新字段
+func (d *ServiceAccountDAO) update(ctx context.Context, clientID string, fields bson.M) (bool, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := collection.UpdateOne(ctx, bson.M{"client_id": clientID}, bson.M{"$set": fields})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
@@ -0,0 +1,20 @@
+package session
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Session 登录会话，每次成功登录创建一条记录
+type Session struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID       primitive.ObjectID `bson:"user_id" json:"userId"`
+	UserAgent    string             `bson:"user_agent" json:"userAgent"` // 登录设备的User-Agent
+	IP           string             `bson:"ip" json:"ip"`                // 登录IP
+	Revoked      bool               `bson:"revoked" json:"revoked"`      // 是否已被吊销
+	CreateTime   time.Time          `bson:"create_time,omitempty" json:"createTime"`
+	LastSeenTime time.Time          `bson:"last_seen_time,omitempty" json:"lastSeenTime"` // 最近活跃时间，登录和刷新令牌时更新
+	ExpireTime   time.Time          `bson:"expire_time,omitempty" json:"expireTime"`      // 会话过期时间，与最新的refresh token一致
+	RevokeTime   time.Time          `bson:"revoke_time,omitempty" json:"revokeTime"`
+}
@@ -0,0 +1,188 @@
+package session
+
+import (
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/util"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ISessionDAO 会话数据访问接口
+type ISessionDAO interface {
+	// Create 创建会话
+	Create(ctx context.Context, session *Session) error
+	// FindByID 通过ID查找会话
+	FindByID(ctx context.Context, id primitive.ObjectID) (*Session, error)
+	// FindActiveByUserID 查找用户所有未吊销且未过期的会话
+	FindActiveByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Session, error)
+	// Touch 更新会话最近活跃时间和过期时间
+	Touch(ctx context.Context, id primitive.ObjectID, expireTime time.Time) error
+	// Revoke 吊销会话
+	Revoke(ctx context.Context, id primitive.ObjectID) error
+	// RevokeByUserID 吊销用户的所有会话，返回被吊销的会话ID
+	RevokeByUserID(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error)
+}
+
+// SessionDAO MongoDB实现的会话DAO
+type SessionDAO struct{}
+
+// 确保SessionDAO实现了ISessionDAO接口
+var _ ISessionDAO = (*SessionDAO)(nil)
+
+// NewSessionDAO 创建会话DAO实例
+func NewSessionDAO() ISessionDAO {
+	return &SessionDAO{}
+}
+
+// 获取会话集合
+func (d *SessionDAO) getCollection() (*mongo.Collection, error) {
+	return util.GetCollection(consts.SessionCollection)
+}
+
+// Create 创建会话
+func (d *SessionDAO) Create(ctx context.Context, session *Session) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	// 生成ID，会话ID同时作为token中的sid和refresh token族ID
+	if session.ID.IsZero() {
+		session.ID = primitive.NewObjectID()
+	}
+
+	// 设置创建时间
+	now := time.Now()
+	session.CreateTime = now
+	session.LastSeenTime = now
+
+	// 插入数据
+	_, err = collection.InsertOne(ctx, session)
+	return err
+}
+
+// FindByID 通过ID查找会话
+func (d *SessionDAO) FindByID(ctx context.Context, id primitive.ObjectID) (*Session, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 执行查询
+	var session Session
+	err = collection.FindOne(ctx, bson.M{"_id": id}).Decode(&session)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 会话不存在
+		}
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// FindActiveByUserID 查找用户所有未吊销且未过期的会话，按最近活跃时间倒序
+func (d *SessionDAO) FindActiveByUserID(ctx context.Context, userID primitive.ObjectID) ([]*Session, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建查询
+	filter := bson.M{
+		"user_id":     userID,
+		"revoked":     false,
+		"expire_time": bson.M{"$gt": time.Now()},
+	}
+	opts := options.Find().SetSort(bson.M{"last_seen_time": -1})
+
+	// 执行查询
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// 解析结果
+	var sessions []*Session
+	err = cursor.All(ctx, &sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Touch 更新会话最近活跃时间和过期时间
+func (d *SessionDAO) Touch(ctx context.Context, id primitive.ObjectID, expireTime time.Time) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"last_seen_time": time.Now(),
+		"expire_time":    expireTime,
+	}}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// Revoke 吊销会话
+func (d *SessionDAO) Revoke(ctx context.Context, id primitive.ObjectID) error {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"revoked":     true,
+		"revoke_time": time.Now(),
+	}}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// RevokeByUserID 吊销用户的所有会话，返回被吊销的会话ID
+func (d *SessionDAO) RevokeByUserID(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	sessions, err := d.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"revoked":     true,
+		"revoke_time": time.Now(),
+	}}
+	_, err = collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
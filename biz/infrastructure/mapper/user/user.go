@@ -1,17 +1,34 @@
 package user
 
 import (
+	"auth/biz/infrastructure/util"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type User struct {
-	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Email      string             `bson:"email" json:"email"`
-	Password   string             `bson:"password" json:"password"`
-	Role       string             `bson:"role" json:"role"` // 用户角色：admin-管理员，user-普通用户
-	CreateTime time.Time          `bson:"create_time,omitempty" json:"createTime"`
-	UpdateTime time.Time          `bson:"update_time,omitempty" json:"updateTime"`
-	DeleteTime time.Time          `bson:"delete_time,omitempty" json:"deleteTime"`
+	ID                    primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Email                 string             `bson:"email,omitempty" json:"email"` // 使用手机号注册的用户为空
+	Phone                 string             `bson:"phone,omitempty" json:"phone"` // E.164格式的手机号，使用邮箱注册的用户为空
+	Password              string             `bson:"password" json:"password"`
+	Role                  string             `bson:"role" json:"role"`                                               // 用户角色：admin-管理员，user-普通用户
+	CredentialSource      string             `bson:"credential_source,omitempty" json:"credentialSource"`            // 密码来源：空-本地密码，ldap-LDAP目录，目录用户的角色在每次登录时按组同步
+	PasswordResetRequired bool               `bson:"password_reset_required,omitempty" json:"passwordResetRequired"` // 密码已泄露等原因需要修改密码，修改或重置后清除
+	PasswordHistory       []string           `bson:"password_history,omitempty" json:"-"`                            // 之前使用过的密码哈希，最新的在前，数量受PasswordPolicy.HistorySize限制
+	TOTPEnabled           bool               `bson:"totp_enabled,omitempty" json:"totpEnabled"`                      // 是否已开启TOTP两步验证
+	TOTPSecret            string             `bson:"totp_secret,omitempty" json:"-"`                                 // AES-GCM加密的TOTP密钥
+	RecoveryCodes         []string           `bson:"recovery_codes,omitempty" json:"-"`                              // 未使用的恢复码摘要，使用后移除，重新生成时整体替换
+	CreateTime            time.Time          `bson:"create_time,omitempty" json:"createTime"`
+	UpdateTime            time.Time          `bson:"update_time,omitempty" json:"updateTime"`
+	DeleteTime            time.Time          `bson:"delete_time,omitempty" json:"deleteTime"`
+}
+
+// LoginIdentifier 用户的登录标识，优先使用邮箱，没有邮箱时使用手机号
+// 登录失败计数和锁定按该标识记录，与登录时提交的标识保持一致；旧数据中的邮箱可能含大写字母，统一转换
+func (u *User) LoginIdentifier() string {
+	if u.Email != "" {
+		return util.NormalizeEmail(u.Email)
+	}
+	return u.Phone
 }
@@ -10,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // IUserDAO 用户数据访问接口
@@ -18,6 +19,8 @@ type IUserDAO interface {
 	Create(ctx context.Context, user *User) error
 	// FindByEmail 通过邮箱查找用户
 	FindByEmail(ctx context.Context, email string) (*User, error)
+	// FindByPhone 通过E.164格式的手机号查找用户
+	FindByPhone(ctx context.Context, phone string) (*User, error)
 	// FindByID 通过ID查找用户
 	FindByID(ctx context.Context, id primitive.ObjectID) (*User, error)
 	// FindByTimestamp 通过时间戳查找用户
@@ -30,6 +33,8 @@ type IUserDAO interface {
 	Update(ctx context.Context, user *User) error
 	// 检查用户是否为管理员
 	CheckIsAdmin(ctx context.Context, id primitive.ObjectID) (bool, error)
+	// ConsumeRecoveryCode 移除一个未使用的恢复码摘要，返回是否移除成功
+	ConsumeRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error)
 }
 
 // UserDAO MongoDB实现的用户DAO
@@ -56,6 +61,11 @@ func (d *UserDAO) Create(ctx context.Context, user *User) error {
 		return err
 	}
 
+	// 生成ID，便于调用方在插入后直接使用
+	if user.ID.IsZero() {
+		user.ID = primitive.NewObjectID()
+	}
+
 	// 设置创建时间
 	now := time.Now()
 	user.CreateTime = now
@@ -79,8 +89,33 @@ func (d *UserDAO) FindByEmail(ctx context.Context, email string) (*User, error)
 		return nil, err
 	}
 
-	// 构建查询
+	// 构建查询，邮箱不区分大小写，兼容统一转换为小写之前注册的用户
 	filter := bson.M{"email": email}
+	opts := options.FindOne().SetCollation(&options.Collation{Locale: "en", Strength: 2})
+
+	// 执行查询
+	var user User
+	err = collection.FindOne(ctx, filter, opts).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // 用户不存在
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// FindByPhone 通过E.164格式的手机号查找用户
+func (d *UserDAO) FindByPhone(ctx context.Context, phone string) (*User, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建查询
+	filter := bson.M{"phone": phone}
 
 	// 执行查询
 	var user User
@@ -205,6 +240,29 @@ func (d *UserDAO) CheckIsAdmin(ctx context.Context, id primitive.ObjectID) (bool
 	return user.Role == consts.RoleAdmin, nil
 }
 
+// ConsumeRecoveryCode 移除一个未使用的恢复码摘要，返回是否移除成功
+// 查询条件包含该摘要，并发请求中只有一个能移除成功，保证每个恢复码只能使用一次
+func (d *UserDAO) ConsumeRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error) {
+	// 获取集合
+	collection, err := d.getCollection()
+	if err != nil {
+		return false, err
+	}
+
+	filter := bson.M{"_id": id, "recovery_codes": codeHash}
+	update := bson.M{
+		"$pull": bson.M{"recovery_codes": codeHash},
+		"$set":  bson.M{"update_time": time.Now()},
+	}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
 // FindByInt64ID 通过int64类型的ID查找用户
 func (d *UserDAO) FindByInt64ID(ctx context.Context, id int64) (*User, error) {
 	// 尝试将int64 ID转换为ObjectID
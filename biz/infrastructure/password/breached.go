@@ -0,0 +1,121 @@
+package password
+
+import (
+	"auth/biz/infrastructure/config"
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sha1Size SHA-1摘要字节数，也是二进制索引中每条记录的长度
+const sha1Size = sha1.Size
+
+// hibpPrefixLength HIBP range格式按SHA-1十六进制摘要的前5位分桶
+const hibpPrefixLength = 5
+
+// IsBreached 检查密码是否出现在本地泄露密码库中，不访问外部网络
+// 支持两种数据源，可同时配置：
+//   - BreachedRangeDir：HIBP range格式目录，按SHA-1前5位分桶，每个文件一行一条"后35位:出现次数"
+//   - BreachedIndexFile：按字节序排序的二进制索引，每条记录为20字节SHA-1摘要
+//
+// 均未配置时始终返回false
+func IsBreached(password string) (bool, error) {
+	policy := config.GetConfig().PasswordPolicy
+	sum := sha1.Sum([]byte(password))
+
+	if policy.BreachedRangeDir != "" {
+		minCount := policy.BreachedMinCount
+		if minCount < 1 {
+			minCount = 1
+		}
+		found, err := lookupRange(policy.BreachedRangeDir, sum, minCount)
+		if err != nil || found {
+			return found, err
+		}
+	}
+
+	if policy.BreachedIndexFile != "" {
+		return lookupIndex(policy.BreachedIndexFile, sum)
+	}
+	return false, nil
+}
+
+// lookupRange 在HIBP range格式目录中查找摘要，出现次数达到minCount才视为泄露
+// 桶文件名为5位大写十六进制前缀，可带.txt后缀；桶文件不存在视为未泄露
+func lookupRange(dir string, sum [sha1Size]byte, minCount int) (bool, error) {
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:hibpPrefixLength], digest[hibpPrefixLength:]
+
+	file, err := os.Open(filepath.Join(dir, prefix))
+	if errors.Is(err, fs.ErrNotExist) {
+		file, err = os.Open(filepath.Join(dir, prefix+".txt"))
+	}
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hashSuffix, countText, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !strings.EqualFold(hashSuffix, suffix) {
+			continue
+		}
+
+		// 缺少出现次数时按1处理；HIBP填充数据的出现次数为0
+		count := 1
+		if countText != "" {
+			if count, err = strconv.Atoi(countText); err != nil {
+				return false, err
+			}
+		}
+		return count >= minCount, nil
+	}
+	return false, scanner.Err()
+}
+
+// lookupIndex 在排序的二进制索引中二分查找摘要
+func lookupIndex(path string, sum [sha1Size]byte) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if info.Size()%sha1Size != 0 {
+		return false, errors.New("泄露密码索引文件长度不是20字节的整数倍: " + path)
+	}
+
+	record := make([]byte, sha1Size)
+	low, high := int64(0), info.Size()/sha1Size-1
+	for low <= high {
+		mid := low + (high-low)/2
+		if _, err = file.ReadAt(record, mid*sha1Size); err != nil {
+			return false, err
+		}
+
+		switch cmp := bytes.Compare(record, sum[:]); {
+		case cmp == 0:
+			return true, nil
+		case cmp < 0:
+			low = mid + 1
+		default:
+			high = mid - 1
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,237 @@
+package password
+
+import (
+	"auth/biz/infrastructure/config"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 密码哈希算法
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// argon2id盐和摘要长度，以及未配置时使用的参数（OWASP推荐的最低配置）
+const (
+	argon2SaltLength         = 16
+	argon2KeyLength          = 32
+	defaultArgon2Memory      = 19 * 1024 // 19MiB
+	defaultArgon2Time        = 2
+	defaultArgon2Parallelism = 1
+)
+
+// ErrUnknownHash 无法识别的密码哈希格式
+var ErrUnknownHash = errors.New("无法识别的密码哈希格式")
+
+// Hasher 密码哈希算法，生成的哈希自描述算法和参数，可独立校验
+type Hasher interface {
+	// Hash 计算密码哈希
+	Hash(password string) (string, error)
+	// Verify 校验密码与哈希是否匹配
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash 哈希的参数与当前配置不一致，需要重新计算
+	NeedsRehash(encoded string) bool
+}
+
+// Hash 使用配置的算法计算密码哈希
+func Hash(password string) (string, error) {
+	return currentHasher().Hash(password)
+}
+
+// Verify 根据哈希前缀选择算法校验密码，兼容历史算法生成的哈希
+func Verify(password, encoded string) (bool, error) {
+	hasher, err := hasherFor(encoded)
+	if err != nil {
+		return false, err
+	}
+	return hasher.Verify(password, encoded)
+}
+
+// MatchesAny 密码是否与任一哈希匹配，用于检查密码历史；无法识别的哈希视为不匹配
+func MatchesAny(password string, hashes ...string) bool {
+	for _, encoded := range hashes {
+		if matched, _ := Verify(password, encoded); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsRehash 哈希的算法或参数与当前配置不一致时返回true，登录成功后应重新计算
+func NeedsRehash(encoded string) bool {
+	hasher, err := hasherFor(encoded)
+	if err != nil {
+		return true
+	}
+	if hasher != currentHasher() {
+		return true
+	}
+	return hasher.NeedsRehash(encoded)
+}
+
+// currentHasher 获取配置的哈希算法，未配置或无法识别时使用argon2id
+func currentHasher() Hasher {
+	hashConfig := config.GetConfig().PasswordHash
+	if hashConfig.Algorithm == AlgorithmBcrypt {
+		return bcryptHasher{cost: hashConfig.BcryptCost}
+	}
+
+	hasher := argon2idHasher{
+		memory:      hashConfig.Argon2Memory,
+		time:        hashConfig.Argon2Time,
+		parallelism: hashConfig.Argon2Parallelism,
+	}
+	if hasher.memory == 0 {
+		hasher.memory = defaultArgon2Memory
+	}
+	if hasher.time == 0 {
+		hasher.time = defaultArgon2Time
+	}
+	if hasher.parallelism == 0 {
+		hasher.parallelism = defaultArgon2Parallelism
+	}
+	return hasher
+}
+
+// hasherFor 根据哈希前缀确定算法，参数使用当前配置
+func hasherFor(encoded string) (Hasher, error) {
+	current := currentHasher()
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		if _, ok := current.(argon2idHasher); ok {
+			return current, nil
+		}
+		return argon2idHasher{}, nil
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		if _, ok := current.(bcryptHasher); ok {
+			return current, nil
+		}
+		return bcryptHasher{}, nil
+	default:
+		return nil, ErrUnknownHash
+	}
+}
+
+// argon2idHasher argon2id哈希，PHC格式：$argon2id$v=19$m=内存KiB,t=迭代次数,p=并行度$盐$摘要
+type argon2idHasher struct {
+	memory      uint32
+	time        uint32
+	parallelism uint8
+}
+
+// argon2idParams 从PHC格式哈希中解析出的参数
+type argon2idParams struct {
+	memory      uint32
+	time        uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// Hash 计算argon2id哈希
+func (h argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.time, h.memory, h.parallelism, argon2KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.memory, h.time, h.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify 使用哈希中记录的参数重新计算并比较
+func (h argon2idHasher) Verify(password, encoded string) (bool, error) {
+	params, err := parseArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey([]byte(password), params.salt, params.time, params.memory, params.parallelism, uint32(len(params.key)))
+	return subtle.ConstantTimeCompare(key, params.key) == 1, nil
+}
+
+// NeedsRehash 参数与当前配置不一致时需要重新计算
+func (h argon2idHasher) NeedsRehash(encoded string) bool {
+	params, err := parseArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return params.memory != h.memory || params.time != h.time || params.parallelism != h.parallelism ||
+		len(params.salt) != argon2SaltLength || len(params.key) != argon2KeyLength
+}
+
+// parseArgon2id 解析PHC格式的argon2id哈希
+func parseArgon2id(encoded string) (*argon2idParams, error) {
+	// 格式：["", "argon2id", "v=19", "m=...,t=...,p=...", 盐, 摘要]
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return nil, ErrUnknownHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrUnknownHash
+	}
+
+	params := &argon2idParams{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.parallelism); err != nil {
+		return nil, ErrUnknownHash
+	}
+
+	var err error
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrUnknownHash
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(params.key) == 0 {
+		return nil, ErrUnknownHash
+	}
+	return params, nil
+}
+
+// bcryptHasher bcrypt哈希，本身即为自描述的$2a$cost$格式
+type bcryptHasher struct {
+	cost int
+}
+
+// Hash 计算bcrypt哈希
+func (h bcryptHasher) Hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.effectiveCost())
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// Verify 校验bcrypt哈希
+func (h bcryptHasher) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// NeedsRehash cost与当前配置不一致时需要重新计算
+func (h bcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost != h.effectiveCost()
+}
+
+// effectiveCost 未配置cost时使用bcrypt.DefaultCost
+func (h bcryptHasher) effectiveCost() int {
+	if h.cost == 0 {
+		return bcrypt.DefaultCost
+	}
+	return h.cost
+}
@@ -0,0 +1,159 @@
+package password
+
+import (
+	"auth/biz/infrastructure/config"
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcryptMaxBytes bcrypt只使用密码的前72字节，更长的部分会被忽略
+const bcryptMaxBytes = 72
+
+// 违反的密码规则
+const (
+	RuleMinLength      = "min_length"       // 长度不足
+	RuleMaxLength      = "max_length"       // 长度超限
+	RuleCharClasses    = "char_classes"     // 字符类别不足
+	RuleEmailLocalPart = "email_local_part" // 包含邮箱用户名
+	RuleCommonPassword = "common_password"  // 属于常见密码
+	RuleBreached       = "breached"         // 出现在泄露密码库中
+	RuleReused         = "reused"           // 与最近使用过的密码相同
+)
+
+// Violation 密码违反的一条策略
+type Violation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+//go:embed common_passwords.txt
+var builtinCommonPasswords string
+
+var (
+	commonPasswords     map[string]struct{}
+	commonPasswordsOnce sync.Once
+)
+
+// Check 按配置的密码策略校验密码，返回违反的全部规则，符合策略时返回空
+func Check(password, email string) []Violation {
+	policy := config.GetConfig().PasswordPolicy
+	var violations []Violation
+
+	// 长度
+	if policy.MinLength > 0 && utf8.RuneCountInString(password) < policy.MinLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMinLength,
+			Message: fmt.Sprintf("密码长度不能少于%d个字符", policy.MinLength),
+		})
+	}
+	maxLength := policy.MaxLength
+	if maxLength <= 0 || maxLength > bcryptMaxBytes {
+		maxLength = bcryptMaxBytes
+	}
+	if len(password) > maxLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMaxLength,
+			Message: fmt.Sprintf("密码长度不能超过%d字节", maxLength),
+		})
+	}
+
+	// 字符类别
+	if policy.MinCharClasses > 0 && countCharClasses(password) < policy.MinCharClasses {
+		violations = append(violations, Violation{
+			Rule:    RuleCharClasses,
+			Message: fmt.Sprintf("密码至少需要包含小写字母、大写字母、数字、符号中的%d类", policy.MinCharClasses),
+		})
+	}
+
+	// 邮箱用户名，过短的用户名不做检查，避免误伤
+	lower := strings.ToLower(password)
+	if policy.RejectEmailLocalPart {
+		localPart, _, _ := strings.Cut(strings.ToLower(email), "@")
+		if len(localPart) >= 3 && strings.Contains(lower, localPart) {
+			violations = append(violations, Violation{
+				Rule:    RuleEmailLocalPart,
+				Message: "密码不能包含邮箱用户名",
+			})
+		}
+	}
+
+	// 常见密码
+	if isCommonPassword(lower) {
+		violations = append(violations, Violation{
+			Rule:    RuleCommonPassword,
+			Message: "密码过于常见，容易被猜到",
+		})
+	}
+
+	// 泄露密码库，读取失败时记录日志并放行，不影响正常注册和修改密码
+	breached, err := IsBreached(password)
+	if err != nil {
+		fmt.Println("检查泄露密码库失败:", err)
+	}
+	if breached {
+		violations = append(violations, Violation{
+			Rule:    RuleBreached,
+			Message: "该密码已出现在公开泄露的密码库中，请更换",
+		})
+	}
+
+	return violations
+}
+
+// countCharClasses 统计密码包含的字符类别数
+func countCharClasses(password string) int {
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasSymbol = true
+		}
+	}
+
+	count := 0
+	for _, has := range []bool{hasLower, hasUpper, hasDigit, hasSymbol} {
+		if has {
+			count++
+		}
+	}
+	return count
+}
+
+// isCommonPassword 检查密码是否在常见密码列表中，比较时不区分大小写
+func isCommonPassword(lower string) bool {
+	commonPasswordsOnce.Do(loadCommonPasswords)
+	_, ok := commonPasswords[lower]
+	return ok
+}
+
+// loadCommonPasswords 加载常见密码列表，配置的文件读取失败时回退到内置列表
+func loadCommonPasswords() {
+	content := builtinCommonPasswords
+	if path := config.GetConfig().PasswordPolicy.CommonPasswordFile; path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Println("读取常见密码列表失败，使用内置列表:", err)
+		} else {
+			content = string(data)
+		}
+	}
+
+	commonPasswords = make(map[string]struct{})
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.ToLower(strings.TrimSpace(line))
+		if line != "" {
+			commonPasswords[line] = struct{}{}
+		}
+	}
+}
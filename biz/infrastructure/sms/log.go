@@ -0,0 +1,53 @@
+package sms
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// logFileMu 串行写入短信文件，避免并发发送时多行内容交错
+var logFileMu sync.Mutex
+
+// LogSender 不真正发送短信，只打印日志；配置了OutputFile时每条短信追加一行JSON，便于测试读取验证码
+type LogSender struct {
+	OutputFile string
+}
+
+// logMessage 写入OutputFile的一行短信记录
+type logMessage struct {
+	To       string `json:"to"`
+	Content  string `json:"content"`
+	SendTime int64  `json:"sendTime"`
+}
+
+// Send 打印短信内容，并追加写入OutputFile
+func (s *LogSender) Send(to, content string) error {
+	fmt.Println("短信（未实际发送）:", to, content)
+	if s.OutputFile == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(&logMessage{
+		To:       to,
+		Content:  content,
+		SendTime: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	logFileMu.Lock()
+	defer logFileMu.Unlock()
+
+	file, err := os.OpenFile(s.OutputFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,73 @@
+package sms
+
+import (
+	"auth/biz/infrastructure/config"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ProviderLog 只打印日志并写入文件的短信发送方式，用于本地开发和测试
+const ProviderLog = "log"
+
+// Sender 短信发送方式，接入短信服务商时实现该接口并通过Register注册
+type Sender interface {
+	// Send 向E.164格式的手机号发送短信
+	Send(to, content string) error
+}
+
+var (
+	sendersMu sync.RWMutex
+	senders   = map[string]Sender{}
+)
+
+// Register 注册短信发送方式，config.SMS.Provider与name相同时使用；重复注册时覆盖
+func Register(name string, sender Sender) {
+	sendersMu.Lock()
+	defer sendersMu.Unlock()
+	senders[name] = sender
+}
+
+// ErrNoProvider 未配置短信发送方式
+var ErrNoProvider = errors.New("未配置短信发送方式")
+
+// currentSender 获取配置的短信发送方式
+// 未配置时返回ErrNoProvider，不会回退到log，避免生产环境把验证码写进日志而不发送短信
+func currentSender() (Sender, error) {
+	smsConfig := config.GetConfig().SMS
+	if smsConfig.Provider == "" {
+		return nil, ErrNoProvider
+	}
+	if smsConfig.Provider == ProviderLog {
+		return &LogSender{OutputFile: smsConfig.OutputFile}, nil
+	}
+
+	sendersMu.RLock()
+	defer sendersMu.RUnlock()
+	sender, ok := senders[smsConfig.Provider]
+	if !ok {
+		return nil, fmt.Errorf("未注册的短信发送方式: %s", smsConfig.Provider)
+	}
+	return sender, nil
+}
+
+// SendSMS 使用配置的发送方式发送短信
+func SendSMS(to, content string) error {
+	sender, err := currentSender()
+	if err != nil {
+		return err
+	}
+	return sender.Send(to, content)
+}
+
+// SendVerificationCode 发送注册验证码短信
+func SendVerificationCode(to, code string) error {
+	fmt.Println("准备发送验证码短信至:", to)
+	return SendSMS(to, fmt.Sprintf("您的验证码是%s，5分钟内有效，请勿泄露给他人。", code))
+}
+
+// SendLoginCode 发送登录验证码短信
+func SendLoginCode(to, code string) error {
+	fmt.Println("准备发送登录验证码短信至:", to)
+	return SendSMS(to, fmt.Sprintf("您的登录验证码是%s，5分钟内有效。如非本人操作，请忽略本短信。", code))
+}
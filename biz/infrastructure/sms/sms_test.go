@@ -0,0 +1,31 @@
+package sms
+
+import (
+	"auth/biz/infrastructure/config"
+	"errors"
+	"testing"
+)
+
+func TestCurrentSender(t *testing.T) {
+	conf := config.GetConfig()
+	previous := conf.SMS
+	defer func() { conf.SMS = previous }()
+
+	// 未配置时不能回退到只打印日志的发送方式
+	conf.SMS.Provider = ""
+	if _, err := currentSender(); !errors.Is(err, ErrNoProvider) {
+		t.Fatalf("err = %v, want ErrNoProvider", err)
+	}
+
+	conf.SMS.Provider = ProviderLog
+	if sender, err := currentSender(); err != nil {
+		t.Fatal(err)
+	} else if _, ok := sender.(*LogSender); !ok {
+		t.Fatalf("sender = %T, want *LogSender", sender)
+	}
+
+	conf.SMS.Provider = "unregistered"
+	if _, err := currentSender(); err == nil {
+		t.Fatal("未注册的发送方式应返回错误")
+	}
+}
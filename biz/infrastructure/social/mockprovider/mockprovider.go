@@ -0,0 +1,276 @@
+package mockprovider
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// 模拟服务商使用的客户端凭据
+const (
+	ClientID     = "mock-client"
+	ClientSecret = "mock-secret"
+)
+
+// User 模拟服务商中的账号
+type User struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name,omitempty"`
+}
+
+// authorization 已签发未使用的授权码
+type authorization struct {
+	subject       string
+	redirectURI   string
+	codeChallenge string
+}
+
+// Server 模拟的OIDC服务商，监听本机端口，用于本地开发和测试，不需要访问外网
+// 授权端点不展示登录页，直接以login_hint指定的账号（未指定时为第一个账号）同意授权
+type Server struct {
+	URL string // 服务地址，同时作为issuer
+
+	listener net.Listener
+	server   *http.Server
+
+	mu     sync.Mutex
+	users  []User
+	codes  map[string]*authorization
+	tokens map[string]string // access token -> 账号ID
+}
+
+// NewServer 在本机随机端口启动模拟服务商
+func NewServer(users ...User) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		URL:      "http://" + listener.Addr().String(),
+		listener: listener,
+		users:    users,
+		codes:    make(map[string]*authorization),
+		tokens:   make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.handleDiscovery)
+	mux.HandleFunc("/authorize", s.handleAuthorize)
+	mux.HandleFunc("/token", s.handleToken)
+	mux.HandleFunc("/userinfo", s.handleUserInfo)
+	s.server = &http.Server{Handler: mux}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Println("模拟第三方登录服务商已停止:", err)
+		}
+	}()
+	return s, nil
+}
+
+// Close 停止模拟服务商
+func (s *Server) Close() error {
+	return s.server.Close()
+}
+
+// AddUser 添加账号
+func (s *Server) AddUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = append(s.users, user)
+}
+
+// ProviderConfig 指向模拟服务商的第三方登录配置，端点通过发现文档获取
+func (s *Server) ProviderConfig(name string, redirectURL string) config.SocialProviderConfig {
+	return config.SocialProviderConfig{
+		Name:         name,
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		Issuer:       s.URL,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{consts.ScopeOpenID, consts.ScopeEmail, consts.ScopeProfile},
+	}
+}
+
+// handleDiscovery 发现文档
+func (s *Server) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                           s.URL,
+		"authorization_endpoint":           s.URL + "/authorize",
+		"token_endpoint":                   s.URL + "/token",
+		"userinfo_endpoint":                s.URL + "/userinfo",
+		"response_types_supported":         []string{"code"},
+		"grant_types_supported":            []string{"authorization_code"},
+		"code_challenge_methods_supported": []string{consts.PKCEMethodS256},
+	})
+}
+
+// handleAuthorize 授权端点，校验请求后直接携带授权码重定向回redirect_uri
+// login_hint为deny时模拟用户拒绝授权
+func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI := query.Get("redirect_uri")
+	if query.Get("client_id") != ClientID || redirectURI == "" {
+		http.Error(w, "invalid client_id or redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	params := url.Values{}
+	params.Set("state", query.Get("state"))
+
+	switch {
+	case query.Get("response_type") != "code":
+		params.Set("error", "unsupported_response_type")
+	case query.Get("login_hint") == "deny":
+		params.Set("error", "access_denied")
+	case query.Get("code_challenge") != "" && query.Get("code_challenge_method") != consts.PKCEMethodS256:
+		params.Set("error", "invalid_request")
+	default:
+		user, ok := s.findUser(query.Get("login_hint"))
+		if !ok {
+			params.Set("error", "access_denied")
+			break
+		}
+
+		code := randomToken()
+		s.mu.Lock()
+		s.codes[code] = &authorization{
+			subject:       user.Subject,
+			redirectURI:   redirectURI,
+			codeChallenge: query.Get("code_challenge"),
+		}
+		s.mu.Unlock()
+		params.Set("code", code)
+	}
+
+	separator := "?"
+	if strings.Contains(redirectURI, "?") {
+		separator = "&"
+	}
+	http.Redirect(w, r, redirectURI+separator+params.Encode(), http.StatusFound)
+}
+
+// handleToken 令牌端点，支持client_secret_post和client_secret_basic认证
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != ClientID || subtle.ConstantTimeCompare([]byte(clientSecret), []byte(ClientSecret)) != 1 {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	// 授权码只能使用一次
+	s.mu.Lock()
+	auth, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	s.mu.Unlock()
+
+	if !ok || auth.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	if auth.codeChallenge != "" {
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if base64.RawURLEncoding.EncodeToString(sum[:]) != auth.codeChallenge {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant")
+			return
+		}
+	}
+
+	accessToken := randomToken()
+	s.mu.Lock()
+	s.tokens[accessToken] = auth.subject
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   consts.TokenType,
+		"expires_in":   3600,
+	})
+}
+
+// handleUserInfo 用户信息端点
+func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get(consts.TokenHeader), consts.TokenType+" ")
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	subject, ok := s.tokens[accessToken]
+	s.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	user, ok := s.findUser(subject)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+// findUser 按账号ID或邮箱查找账号，hint为空时返回第一个账号
+func (s *Server) findUser(hint string) (User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, user := range s.users {
+		if hint == "" || user.Subject == hint || user.Email == hint {
+			return user, true
+		}
+	}
+	return User{}, false
+}
+
+// randomToken 生成随机的授权码或access token
+func randomToken() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// writeJSON 输出JSON响应
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeOAuthError 输出OAuth错误响应
+func writeOAuthError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, map[string]string{"error": code})
+}
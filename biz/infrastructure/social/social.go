@@ -0,0 +1,367 @@
+package social
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// requestTimeout 请求第三方服务商的超时时间
+const requestTimeout = 10 * time.Second
+
+// maxResponseSize 第三方服务商响应的最大长度
+const maxResponseSize = 1 << 20
+
+// ErrProviderNotFound 未配置该服务商
+var ErrProviderNotFound = errors.New("social provider not found")
+
+// httpClient 请求第三方服务商使用的客户端，不跟随重定向，避免令牌被转发到其他地址
+var httpClient = &http.Client{
+	Timeout: requestTimeout,
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// Profile 第三方账号信息
+type Profile struct {
+	Subject       string // 服务商内的账号唯一ID
+	Email         string // 邮箱，服务商未返回时为空
+	EmailVerified bool   // 服务商是否确认邮箱归属
+}
+
+// Provider 第三方登录服务商
+type Provider struct {
+	cfg config.SocialProviderConfig
+
+	// 从OIDC发现文档中获取的端点，配置中未填写时使用
+	mu        sync.Mutex
+	discovery *discoveryDocument
+}
+
+// discoveryDocument OIDC发现文档中使用的字段
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// tokenResponse 令牌端点的响应（RFC 6749 5.1、5.2）
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+var (
+	providersOnce sync.Once
+	providers     map[string]*Provider
+)
+
+// GetProvider 按名称获取已配置的服务商
+func GetProvider(name string) (*Provider, error) {
+	providersOnce.Do(func() {
+		providers = make(map[string]*Provider)
+		for _, cfg := range config.GetConfig().SocialLogin.Providers {
+			if !validName(cfg.Name) {
+				fmt.Println("第三方登录服务商名称无效，已忽略:", cfg.Name)
+				continue
+			}
+			providers[cfg.Name] = &Provider{cfg: cfg}
+		}
+	})
+
+	provider, ok := providers[name]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+	return provider, nil
+}
+
+// Name 服务商名称
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// IdentityType 该服务商的账号在登录标识集合中的类型
+func (p *Provider) IdentityType() string {
+	return consts.IdentityTypeSocialPrefix + p.cfg.Name
+}
+
+// UsePKCE 是否使用PKCE
+func (p *Provider) UsePKCE() bool {
+	return !p.cfg.DisablePKCE
+}
+
+// AuthCodeURL 生成跳转到服务商授权页的地址，codeChallenge为空时不使用PKCE
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, codeChallenge string) (string, error) {
+	authURL, _, _, err := p.endpoints(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		return "", fmt.Errorf("授权端点无效: %w", err)
+	}
+
+	query := parsed.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("state", state)
+	if len(p.cfg.Scopes) > 0 {
+		query.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	}
+	if codeChallenge != "" {
+		query.Set("code_challenge", codeChallenge)
+		query.Set("code_challenge_method", consts.PKCEMethodS256)
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// Exchange 使用授权码换取access token，codeVerifier为空时不使用PKCE
+func (p *Provider) Exchange(ctx context.Context, code string, codeVerifier string) (string, error) {
+	_, tokenURL, _, err := p.endpoints(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("client_secret", p.cfg.ClientSecret)
+	if codeVerifier != "" {
+		form.Set("code_verifier", codeVerifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub等服务商默认返回表单格式，需要显式要求JSON
+	req.Header.Set("Accept", "application/json")
+
+	body, status, err := doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	var token tokenResponse
+	if err = json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("解析令牌响应失败，状态码%d: %w", status, err)
+	}
+	if token.Error != "" {
+		return "", fmt.Errorf("令牌端点返回错误: %s %s", token.Error, token.ErrorDescription)
+	}
+	if status != http.StatusOK || token.AccessToken == "" {
+		return "", fmt.Errorf("令牌端点返回状态码%d，未返回access_token", status)
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, consts.TokenType) {
+		return "", fmt.Errorf("不支持的token_type: %s", token.TokenType)
+	}
+	return token.AccessToken, nil
+}
+
+// FetchProfile 使用access token获取第三方账号信息
+func (p *Provider) FetchProfile(ctx context.Context, accessToken string) (*Profile, error) {
+	_, _, userInfoURL, err := p.endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(consts.TokenHeader, consts.TokenType+" "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	body, status, err := doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("用户信息端点返回状态码%d", status)
+	}
+
+	// 使用json.Number保留数字ID的原样，如GitHub的id
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var claims map[string]interface{}
+	if err = decoder.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("解析用户信息失败: %w", err)
+	}
+
+	profile := &Profile{
+		Subject: stringClaim(claims, fieldOrDefault(p.cfg.SubjectField, "sub")),
+		Email:   strings.TrimSpace(stringClaim(claims, fieldOrDefault(p.cfg.EmailField, "email"))),
+	}
+	if profile.Subject == "" {
+		return nil, errors.New("用户信息中没有账号ID")
+	}
+	if profile.Email != "" {
+		profile.EmailVerified = p.cfg.TrustEmail || boolClaim(claims, fieldOrDefault(p.cfg.EmailVerifiedField, "email_verified"))
+	}
+	return profile, nil
+}
+
+// endpoints 获取授权、令牌和用户信息端点，配置中未填写的从OIDC发现文档中获取
+func (p *Provider) endpoints(ctx context.Context) (string, string, string, error) {
+	authURL, tokenURL, userInfoURL := p.cfg.AuthURL, p.cfg.TokenURL, p.cfg.UserInfoURL
+	if authURL != "" && tokenURL != "" && userInfoURL != "" {
+		return authURL, tokenURL, userInfoURL, nil
+	}
+	if p.cfg.Issuer == "" {
+		return "", "", "", errors.New("未配置服务商端点，也未配置Issuer")
+	}
+
+	doc, err := p.loadDiscovery(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+	if authURL == "" {
+		authURL = doc.AuthorizationEndpoint
+	}
+	if tokenURL == "" {
+		tokenURL = doc.TokenEndpoint
+	}
+	if userInfoURL == "" {
+		userInfoURL = doc.UserInfoEndpoint
+	}
+	if authURL == "" || tokenURL == "" || userInfoURL == "" {
+		return "", "", "", errors.New("发现文档中缺少授权、令牌或用户信息端点")
+	}
+	return authURL, tokenURL, userInfoURL, nil
+}
+
+// loadDiscovery 获取OIDC发现文档，成功后缓存，失败时下次请求重试
+func (p *Provider) loadDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	body, status, err := doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("发现文档返回状态码%d", status)
+	}
+
+	var doc discoveryDocument
+	if err = json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("解析发现文档失败: %w", err)
+	}
+	// issuer必须与配置一致（OpenID Connect Discovery 4.3）
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("发现文档中的issuer与配置不一致: %s", doc.Issuer)
+	}
+	p.discovery = &doc
+	return p.discovery, nil
+}
+
+// GenerateCodeVerifier 生成PKCE code_verifier（RFC 7636 4.1）
+func GenerateCodeVerifier() (string, error) {
+	buf := make([]byte, consts.SocialCodeVerifierBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallengeS256 计算PKCE code_challenge：BASE64URL(SHA256(code_verifier))
+func CodeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// doRequest 发送请求并读取响应，限制响应长度
+func doRequest(req *http.Request) ([]byte, int, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	return body, resp.StatusCode, nil
+}
+
+// validName 服务商名称只能包含小写字母、数字和-
+func validName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldOrDefault 未配置字段名时使用默认字段
+func fieldOrDefault(field string, defaultField string) string {
+	if field == "" {
+		return defaultField
+	}
+	return field
+}
+
+// stringClaim 读取字符串或数字字段
+func stringClaim(claims map[string]interface{}, field string) string {
+	switch value := claims[field].(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	default:
+		return ""
+	}
+}
+
+// boolClaim 读取布尔字段，部分服务商以字符串返回
+func boolClaim(claims map[string]interface{}, field string) bool {
+	switch value := claims[field].(type) {
+	case bool:
+		return value
+	case string:
+		parsed, _ := strconv.ParseBool(value)
+		return parsed
+	default:
+		return false
+	}
+}
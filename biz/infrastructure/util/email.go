@@ -0,0 +1,9 @@
+package util
+
+import "strings"
+
+// NormalizeEmail 将邮箱统一为去掉首尾空白的小写形式，作为冷却、冻结、登录锁定和用户查找的标识
+// 同一邮箱的不同大小写写法视为同一账号
+func NormalizeEmail(raw string) string {
+	return strings.ToLower(strings.TrimSpace(raw))
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"auth/biz/infrastructure/consts"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// GenerateMagicLinkNonce 生成绑定发起登录浏览器的随机nonce
+func GenerateMagicLinkNonce() (string, error) {
+	return GenerateRandomHex(consts.MagicLinkNonceBytes)
+}
+
+// HashMagicLinkNonce 登录链接token中只保存nonce的摘要，nonce本身只存在于浏览器cookie中
+func HashMagicLinkNonce(nonce string) string {
+	sum := sha256.Sum256([]byte(nonce))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetMagicLinkKey 获取登录链接在Redis中的键
+func GetMagicLinkKey(tokenID string) string {
+	return consts.MagicLinkPrefix + tokenID
+}
+
+// SaveMagicLink 记录未使用的登录链接，过期时间与链接一致
+func SaveMagicLink(ctx context.Context, tokenID string, userID string) error {
+	return SetWithExpire(ctx, GetMagicLinkKey(tokenID), userID, time.Duration(consts.MagicLinkExpire)*time.Second)
+}
+
+// ConsumeMagicLink 取出并删除登录链接记录，保证并发请求中只有一个能使用同一链接
+// 链接已使用或已过期时返回空字符串
+func ConsumeMagicLink(ctx context.Context, tokenID string) (string, error) {
+	userID, err := GetDel(ctx, GetMagicLinkKey(tokenID))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return userID, nil
+}
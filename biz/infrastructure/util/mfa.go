@@ -0,0 +1,82 @@
+package util
+
+import (
+	"auth/biz/infrastructure/consts"
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetTOTPEnrollKey 获取待确认TOTP密钥在Redis中的键
+func GetTOTPEnrollKey(userID string) string {
+	return consts.TOTPEnrollPrefix + userID
+}
+
+// GetTOTPUsedKey 获取已使用TOTP时间步在Redis中的键
+func GetTOTPUsedKey(userID string, step int64) string {
+	return fmt.Sprintf("%s%s:%d", consts.TOTPUsedPrefix, userID, step)
+}
+
+// GetMFAPendingKey 获取待完成两步验证的登录在Redis中的键
+func GetMFAPendingKey(tokenID string) string {
+	return consts.MFAPendingPrefix + tokenID
+}
+
+// SaveTOTPEnrollment 保存待确认的TOTP密钥（已加密），重新开始绑定时覆盖
+func SaveTOTPEnrollment(ctx context.Context, userID string, encryptedSecret string) error {
+	return SetWithExpire(ctx, GetTOTPEnrollKey(userID), encryptedSecret, time.Duration(consts.TOTPEnrollExpire)*time.Second)
+}
+
+// GetTOTPEnrollment 获取待确认的TOTP密钥，不存在时返回空字符串
+func GetTOTPEnrollment(ctx context.Context, userID string) (string, error) {
+	secret, err := Get(ctx, GetTOTPEnrollKey(userID))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return secret, nil
+}
+
+// DeleteTOTPEnrollment 删除待确认的TOTP密钥
+func DeleteTOTPEnrollment(ctx context.Context, userID string) error {
+	return Del(ctx, GetTOTPEnrollKey(userID))
+}
+
+// MarkTOTPStepUsed 记录用户已使用的TOTP时间步，返回false表示该时间步的验证码已被使用过
+// 记录保留到该时间步的验证码在允许的时钟偏差内都已失效
+func MarkTOTPStepUsed(ctx context.Context, userID string, step int64) (bool, error) {
+	expire := time.Duration(consts.TOTPPeriod*(2*consts.TOTPSkew+2)) * time.Second
+	return SetNX(ctx, GetTOTPUsedKey(userID, step), "1", expire)
+}
+
+// SaveMFAPending 记录待完成两步验证的登录，过期时间与mfa token一致
+func SaveMFAPending(ctx context.Context, tokenID string, userID string) error {
+	return SetWithExpire(ctx, GetMFAPendingKey(tokenID), userID, time.Duration(consts.MFAPendingExpire)*time.Second)
+}
+
+// GetMFAPending 获取待完成两步验证的登录对应的用户ID，已完成或已过期时返回空字符串
+func GetMFAPending(ctx context.Context, tokenID string) (string, error) {
+	userID, err := Get(ctx, GetMFAPendingKey(tokenID))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return userID, nil
+}
+
+// ConsumeMFAPending 取出并删除待完成两步验证的登录，保证每个mfa token只能换取一次令牌
+// 已完成或已过期时返回空字符串
+func ConsumeMFAPending(ctx context.Context, tokenID string) (string, error) {
+	userID, err := GetDel(ctx, GetMFAPendingKey(tokenID))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return userID, nil
+}
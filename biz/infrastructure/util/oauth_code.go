@@ -0,0 +1,89 @@
+package util
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// OAuthCodeData 授权码在Redis中保存的数据
+type OAuthCodeData struct {
+	ClientID      string `json:"clientId"`
+	RedirectURI   string `json:"redirectUri"` // 授权请求中的redirect_uri，换取token时必须一致
+	UserID        string `json:"userId"`
+	Email         string `json:"email"`
+	Scope         string `json:"scope"`
+	CodeChallenge string `json:"codeChallenge,omitempty"` // PKCE S256挑战值
+	Nonce         string `json:"nonce,omitempty"`         // OIDC nonce，写入ID Token
+	AuthTime      int64  `json:"authTime"`                // 用户完成认证的时间
+}
+
+// GenerateOAuthCode 生成随机的授权码
+func GenerateOAuthCode() (string, error) {
+	return GenerateRandomHex(consts.OAuthCodeBytes)
+}
+
+// hashOAuthCode Redis中只保存授权码的摘要
+func hashOAuthCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetOAuthCodeKey 获取授权码在Redis中的键
+func GetOAuthCodeKey(code string) string {
+	return consts.OAuthCodePrefix + hashOAuthCode(code)
+}
+
+// GetOAuthCodeUsedKey 获取已使用授权码在Redis中的键
+func GetOAuthCodeUsedKey(code string) string {
+	return consts.OAuthCodeUsedPrefix + hashOAuthCode(code)
+}
+
+// SaveOAuthCode 保存授权码
+func SaveOAuthCode(ctx context.Context, code string, data *OAuthCodeData) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return SetWithExpire(ctx, GetOAuthCodeKey(code), value, time.Duration(consts.OAuthCodeExpire)*time.Second)
+}
+
+// ConsumeOAuthCode 取出并删除授权码，保证每个授权码只能使用一次
+// 授权码不存在时返回nil
+func ConsumeOAuthCode(ctx context.Context, code string) (*OAuthCodeData, error) {
+	value, err := GetDel(ctx, GetOAuthCodeKey(code))
+	if err != nil {
+		if IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var data OAuthCodeData
+	if err = json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// MarkOAuthCodeUsed 记录授权码换取token时创建的会话，用于授权码重放时吊销该会话
+func MarkOAuthCodeUsed(ctx context.Context, code string, sessionID string) error {
+	expire := time.Duration(config.GetConfig().JWT.RefreshExpireTime) * time.Second
+	return SetWithExpire(ctx, GetOAuthCodeUsedKey(code), sessionID, expire)
+}
+
+// GetUsedOAuthCodeSession 获取已使用授权码对应的会话，未被使用过时返回空字符串
+func GetUsedOAuthCodeSession(ctx context.Context, code string) (string, error) {
+	sessionID, err := Get(ctx, GetOAuthCodeUsedKey(code))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,39 @@
+package util
+
+import (
+	"auth/biz/infrastructure/consts"
+	"context"
+	"time"
+)
+
+// GetPasskeyCeremonyKey 获取通行密钥注册或登录仪式在Redis中的键，不同类型的仪式分开存储
+func GetPasskeyCeremonyKey(kind string, ceremonyID string) string {
+	return consts.PasskeyCeremonyPrefix + kind + ":" + ceremonyID
+}
+
+// SavePasskeyCeremony 保存进行中的仪式数据（JSON），返回仪式ID
+func SavePasskeyCeremony(ctx context.Context, kind string, data string) (string, error) {
+	ceremonyID, err := GenerateRandomHex(consts.PasskeyCeremonyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	err = SetWithExpire(ctx, GetPasskeyCeremonyKey(kind, ceremonyID), data, time.Duration(consts.PasskeyCeremonyExpire)*time.Second)
+	if err != nil {
+		return "", err
+	}
+	return ceremonyID, nil
+}
+
+// ConsumePasskeyCeremony 取出并删除仪式数据，每个challenge只能使用一次
+// 已使用或已过期时返回空字符串
+func ConsumePasskeyCeremony(ctx context.Context, kind string, ceremonyID string) (string, error) {
+	data, err := GetDel(ctx, GetPasskeyCeremonyKey(kind, ceremonyID))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return data, nil
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"auth/biz/infrastructure/config"
+	"errors"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// ErrPhoneInvalid 无法解析或不是有效号码的手机号
+var ErrPhoneInvalid = errors.New("手机号格式不正确")
+
+// NormalizePhone 将手机号统一为E.164格式（如+8613800138000），作为冷却、冻结、登录锁定和用户查找的标识
+// 未带国际区号时按config.SMS.DefaultRegion解析；只接受可以接收短信的手机号码
+func NormalizePhone(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", ErrPhoneInvalid
+	}
+
+	number, err := phonenumbers.Parse(raw, config.GetConfig().SMS.DefaultRegion)
+	if err != nil || !phonenumbers.IsValidNumber(number) {
+		return "", ErrPhoneInvalid
+	}
+
+	switch phonenumbers.GetNumberType(number) {
+	case phonenumbers.MOBILE, phonenumbers.FIXED_LINE_OR_MOBILE:
+	default:
+		return "", ErrPhoneInvalid
+	}
+	return phonenumbers.Format(number, phonenumbers.E164), nil
+}
@@ -0,0 +1,15 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// GenerateRandomHex 生成指定字节数的随机串，以十六进制编码
+func GenerateRandomHex(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,48 @@
+package util
+
+import (
+	"auth/biz/infrastructure/consts"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+)
+
+// 恢复码使用小写base32字符，便于抄写和输入
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// GenerateRecoveryCodes 生成一组恢复码，返回明文和对应的摘要
+// 明文只在生成时返回给用户一次，数据库中只保存摘要
+func GenerateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, consts.RecoveryCodeCount)
+	hashes := make([]string, 0, consts.RecoveryCodeCount)
+	for i := 0; i < consts.RecoveryCodeCount; i++ {
+		buf := make([]byte, consts.RecoveryCodeBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+
+		// 每4个字符用-分隔，便于抄写
+		raw := recoveryCodeEncoding.EncodeToString(buf)
+		groups := make([]string, 0, len(raw)/4+1)
+		for start := 0; start < len(raw); start += 4 {
+			end := min(start+4, len(raw))
+			groups = append(groups, raw[start:end])
+		}
+		code := strings.Join(groups, "-")
+
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode 计算恢复码摘要，忽略大小写、空格和分隔符
+// 恢复码是80位随机数，不需要使用密码哈希的慢速算法
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(code)
+	normalized = strings.NewReplacer("-", "", " ", "").Replace(normalized)
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
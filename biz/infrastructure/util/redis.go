@@ -53,6 +53,15 @@ func SetWithExpire(ctx context.Context, key string, value interface{}, expiratio
 	return client.Set(ctx, key, value, expiration).Err()
 }
 
+// SetNX 键不存在时设置键值对，带过期时间；返回是否设置成功（原子操作）
+func SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	client, err := GetRedisClient()
+	if err != nil {
+		return false, err
+	}
+	return client.SetNX(ctx, key, value, expiration).Result()
+}
+
 // Get 获取值
 func Get(ctx context.Context, key string) (string, error) {
 	client, err := GetRedisClient()
@@ -62,6 +71,36 @@ func Get(ctx context.Context, key string) (string, error) {
 	return client.Get(ctx, key).Result()
 }
 
+// GetDel 获取值并删除键（原子操作）
+func GetDel(ctx context.Context, key string) (string, error) {
+	client, err := GetRedisClient()
+	if err != nil {
+		return "", err
+	}
+	return client.GetDel(ctx, key).Result()
+}
+
+// compareAndDeleteScript 值与预期一致时才删除键
+var compareAndDeleteScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// CompareAndDelete 键的值与expected一致时删除（原子操作），返回是否删除
+func CompareAndDelete(ctx context.Context, key string, expected string) (bool, error) {
+	client, err := GetRedisClient()
+	if err != nil {
+		return false, err
+	}
+	deleted, err := compareAndDeleteScript.Run(ctx, client, []string{key}, expected).Int()
+	if err != nil {
+		return false, err
+	}
+	return deleted == 1, nil
+}
+
 // Del 删除键
 func Del(ctx context.Context, key string) error {
 	client, err := GetRedisClient()
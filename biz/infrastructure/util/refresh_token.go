@@ -0,0 +1,145 @@
+package util
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// RefreshTokenData refresh token在Redis中保存的数据
+type RefreshTokenData struct {
+	UserID        string `json:"userId"`
+	Email         string `json:"email"`
+	FamilyID      string `json:"familyId"`           // 同一次登录轮换出的所有refresh token属于同一个族
+	ClientID      string `json:"clientId,omitempty"` // 通过OAuth客户端签发时为客户端ID，刷新时必须由同一客户端使用
+	Scope         string `json:"scope,omitempty"`    // 授权范围，刷新后保持不变
+	IssuedAt      int64  `json:"issuedAt"`
+	ExpireAt      int64  `json:"expireAt"`
+	IssuedAtMilli int64  `json:"issuedAtMilli,omitempty"` // 签发时间（Unix毫秒），与用户的吊销时间比较时使用
+}
+
+// GenerateRefreshToken 生成随机的refresh token
+func GenerateRefreshToken() (string, error) {
+	buf := make([]byte, consts.RefreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// hashRefreshToken Redis中只保存refresh token的摘要，避免泄露后可直接使用
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetRefreshTokenKey 获取refresh token在Redis中的键
+func GetRefreshTokenKey(token string) string {
+	return consts.RefreshTokenPrefix + hashRefreshToken(token)
+}
+
+// GetRefreshTokenUsedKey 获取已轮换refresh token在Redis中的键
+func GetRefreshTokenUsedKey(token string) string {
+	return consts.RefreshTokenUsedPrefix + hashRefreshToken(token)
+}
+
+// GetRefreshFamilyRevokedKey 获取refresh token族吊销标记在Redis中的键
+func GetRefreshFamilyRevokedKey(familyID string) string {
+	return consts.RefreshFamilyRevokedPrefix + familyID
+}
+
+// SaveRefreshToken 保存refresh token，过期时间与token一致
+func SaveRefreshToken(ctx context.Context, token string, data *RefreshTokenData) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return SetWithExpire(ctx, GetRefreshTokenKey(token), value, time.Until(time.Unix(data.ExpireAt, 0)))
+}
+
+// ErrRefreshTokenClient refresh token不属于发起刷新的客户端
+var ErrRefreshTokenClient = errors.New("refresh token不属于该客户端")
+
+// ConsumeRefreshToken 取出并删除refresh token，保证每个token只能被使用一次
+// 先校验签发该token的客户端，与clientID不一致时返回ErrRefreshTokenClient且不删除，其他客户端无法消耗该token
+// 读取后以比较删除的方式消费，并发使用同一token时只有一个请求能取得数据；token不存在时返回nil
+func ConsumeRefreshToken(ctx context.Context, token string, clientID string) (*RefreshTokenData, error) {
+	key := GetRefreshTokenKey(token)
+	value, err := Get(ctx, key)
+	if err != nil {
+		if IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var data RefreshTokenData
+	if err = json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, err
+	}
+	if data.ClientID != clientID {
+		return nil, ErrRefreshTokenClient
+	}
+
+	deleted, err := CompareAndDelete(ctx, key, value)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, nil
+	}
+	return &data, nil
+}
+
+// GetRefreshToken 读取refresh token但不消费，token不存在时返回nil
+func GetRefreshToken(ctx context.Context, token string) (*RefreshTokenData, error) {
+	value, err := Get(ctx, GetRefreshTokenKey(token))
+	if err != nil {
+		if IsRedisNil(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var data RefreshTokenData
+	if err = json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// MarkRefreshTokenUsed 记录已被轮换的refresh token及其所属族，用于检测重放
+func MarkRefreshTokenUsed(ctx context.Context, token string, data *RefreshTokenData) error {
+	return SetWithExpire(ctx, GetRefreshTokenUsedKey(token), data.FamilyID, time.Until(time.Unix(data.ExpireAt, 0)))
+}
+
+// GetUsedRefreshTokenFamily 获取已轮换refresh token所属的族，未被轮换过时返回空字符串
+func GetUsedRefreshTokenFamily(ctx context.Context, token string) (string, error) {
+	familyID, err := Get(ctx, GetRefreshTokenUsedKey(token))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return familyID, nil
+}
+
+// RevokeRefreshFamily 吊销整个refresh token族
+// 标记保留一个refresh token的完整有效期，覆盖族内所有仍可能有效的token
+func RevokeRefreshFamily(ctx context.Context, familyID string) error {
+	key := GetRefreshFamilyRevokedKey(familyID)
+	expire := time.Duration(config.GetConfig().JWT.RefreshExpireTime) * time.Second
+	return SetWithExpire(ctx, key, time.Now().Unix(), expire)
+}
+
+// IsRefreshFamilyRevoked 检查refresh token族是否已被吊销
+func IsRefreshFamilyRevoked(ctx context.Context, familyID string) (bool, error) {
+	return Exists(ctx, GetRefreshFamilyRevokedKey(familyID))
+}
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"auth/biz/infrastructure/config"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+)
+
+// ErrSecretKeyMissing 未配置MFA加密密钥
+var ErrSecretKeyMissing = errors.New("未配置MFA加密密钥")
+
+// SecretKeyConfigured 是否已配置MFA加密密钥
+func SecretKeyConfigured() bool {
+	return config.GetConfig().MFA.EncryptionKey != ""
+}
+
+// secretCipher 使用配置的MFA加密密钥创建AES-256-GCM
+func secretCipher() (cipher.AEAD, error) {
+	if !SecretKeyConfigured() {
+		return nil, ErrSecretKeyMissing
+	}
+
+	key, err := base64.StdEncoding.DecodeString(config.GetConfig().MFA.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, errors.New("MFA加密密钥必须是32字节")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptSecret 加密需要落库的敏感数据（如TOTP密钥），结果为base64编码的nonce+密文
+func EncryptSecret(plaintext string) (string, error) {
+	aead, err := secretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptSecret 解密EncryptSecret加密的数据
+func DecryptSecret(encrypted string) (string, error) {
+	aead, err := secretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("密文长度无效")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
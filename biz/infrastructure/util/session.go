@@ -0,0 +1,26 @@
+package util
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"context"
+	"time"
+)
+
+// GetSessionRevokedKey 获取会话吊销标记在Redis中的键
+func GetSessionRevokedKey(sessionID string) string {
+	return consts.SessionRevokedPrefix + sessionID
+}
+
+// MarkSessionRevoked 记录会话已被吊销，供每次请求校验token时快速检查
+// 标记保留一个refresh token的完整有效期，之后该会话签发的token均已过期
+func MarkSessionRevoked(ctx context.Context, sessionID string) error {
+	key := GetSessionRevokedKey(sessionID)
+	expire := time.Duration(config.GetConfig().JWT.RefreshExpireTime) * time.Second
+	return SetWithExpire(ctx, key, time.Now().Unix(), expire)
+}
+
+// IsSessionRevoked 检查会话是否已被吊销
+func IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	return Exists(ctx, GetSessionRevokedKey(sessionID))
+}
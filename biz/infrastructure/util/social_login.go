@@ -0,0 +1,35 @@
+package util
+
+import (
+	"auth/biz/infrastructure/consts"
+	"context"
+	"time"
+)
+
+// GenerateSocialState 生成第三方登录的state
+func GenerateSocialState() (string, error) {
+	return GenerateRandomHex(consts.SocialStateBytes)
+}
+
+// GetSocialStateKey 获取第三方登录state在Redis中的键
+func GetSocialStateKey(state string) string {
+	return consts.SocialStatePrefix + state
+}
+
+// SaveSocialState 保存进行中的第三方登录数据（JSON），过期时间与state cookie一致
+func SaveSocialState(ctx context.Context, state string, data string) error {
+	return SetWithExpire(ctx, GetSocialStateKey(state), data, time.Duration(consts.SocialStateExpire)*time.Second)
+}
+
+// ConsumeSocialState 取出并删除第三方登录数据，每个state只能使用一次
+// 已使用或已过期时返回空字符串
+func ConsumeSocialState(ctx context.Context, state string) (string, error) {
+	data, err := GetDel(ctx, GetSocialStateKey(state))
+	if err != nil {
+		if IsRedisNil(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return data, nil
+}
@@ -0,0 +1,137 @@
+package util
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GenerateTokenID 生成token的唯一标识（jti）
+func GenerateTokenID() (string, error) {
+	buf := make([]byte, consts.TokenIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// GetTokenBlacklistKey 获取token黑名单在Redis中的键
+func GetTokenBlacklistKey(tokenID string) string {
+	return consts.TokenBlacklistPrefix + tokenID
+}
+
+// GetTokenRevokeBeforeKey 获取用户token吊销时间在Redis中的键
+func GetTokenRevokeBeforeKey(userID string) string {
+	return consts.TokenRevokeBeforePrefix + userID
+}
+
+// AddTokenToBlacklist 将单个token加入黑名单，黑名单记录随token过期而过期
+func AddTokenToBlacklist(ctx context.Context, tokenID string, expireAt int64) error {
+	ttl := time.Until(time.Unix(expireAt, 0))
+	if ttl <= 0 {
+		// token已过期，无需拉黑
+		return nil
+	}
+
+	key := GetTokenBlacklistKey(tokenID)
+	return SetWithExpire(ctx, key, time.Now().Unix(), ttl)
+}
+
+// IsTokenInBlacklist 检查token是否在黑名单中
+func IsTokenInBlacklist(ctx context.Context, tokenID string) (bool, error) {
+	key := GetTokenBlacklistKey(tokenID)
+	return Exists(ctx, key)
+}
+
+// RevokeUserTokensBefore 吊销用户在指定时间之前签发的所有token（包括refresh token）
+// 吊销时间精确到毫秒，记录保留到该时间之前签发的token全部过期为止，之后重新登录签发的token不受影响
+func RevokeUserTokensBefore(ctx context.Context, userID string, before time.Time) error {
+	return RevokeUserTokensExcept(ctx, userID, before, "")
+}
+
+// RevokeUserTokensExcept 与RevokeUserTokensBefore相同，但keepSessionID会话的token不受影响
+// 用于修改密码后保留当前会话；没有会话ID的旧token同样被吊销。之后再次整体吊销时覆盖该记录
+func RevokeUserTokensExcept(ctx context.Context, userID string, before time.Time, keepSessionID string) error {
+	jwtConfig := config.GetConfig().JWT
+	expire := jwtConfig.ExpireTime
+	if jwtConfig.RefreshExpireTime > expire {
+		expire = jwtConfig.RefreshExpireTime
+	}
+
+	// 记录格式为“吊销时间”或“吊销时间:保留的会话ID”
+	value := strconv.FormatInt(before.UnixMilli(), 10)
+	if keepSessionID != "" {
+		value += ":" + keepSessionID
+	}
+
+	key := GetTokenRevokeBeforeKey(userID)
+	return SetWithExpire(ctx, key, value, time.Duration(expire)*time.Second)
+}
+
+// GetUserTokensRevokeTime 获取用户token吊销时间（Unix毫秒），未吊销或sessionID是吊销时保留的会话时返回0
+func GetUserTokensRevokeTime(ctx context.Context, userID string, sessionID string) (int64, error) {
+	value, err := Get(ctx, GetTokenRevokeBeforeKey(userID))
+	if err != nil {
+		if IsRedisNil(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	value, keepSessionID, _ := strings.Cut(value, ":")
+	if keepSessionID != "" && keepSessionID == sessionID {
+		return 0, nil
+	}
+
+	revokeTime, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	// 升级前写入的吊销时间精确到秒，取该秒的最后一毫秒
+	if revokeTime < secondsRevokeTimeLimit {
+		revokeTime = revokeTime*1000 + 999
+	}
+	return revokeTime, nil
+}
+
+// secondsRevokeTimeLimit 小于该值的吊销时间是以秒记录的，以毫秒记录时对应2001年
+const secondsRevokeTimeLimit = 1_000_000_000_000
+
+// TokenIssuedAtMilli token的签发时间（Unix毫秒）
+// 升级前签发的token只记录了精确到秒的签发时间，按该秒的第一毫秒计算，与吊销同一秒签发的视为已吊销
+func TokenIssuedAtMilli(issuedAt int64, issuedAtMilli int64) int64 {
+	if issuedAtMilli > 0 {
+		return issuedAtMilli
+	}
+	return issuedAt * 1000
+}
+
+// IssuedBeforeRevoke 签发时间不晚于用户吊销时间的token视为已吊销，时间均为Unix毫秒
+func IssuedBeforeRevoke(issuedAtMilli int64, revokeTime int64) bool {
+	return revokeTime > 0 && issuedAtMilli <= revokeTime
+}
+
+// IsTokenRevoked 检查token是否已被吊销：jti在黑名单中，或签发时间不晚于用户的吊销时间
+// sessionID为token所属会话，issuedAtMilli为签发时间（Unix毫秒），由TokenIssuedAtMilli计算
+func IsTokenRevoked(ctx context.Context, tokenID, userID, sessionID string, issuedAtMilli int64) (bool, error) {
+	if tokenID != "" {
+		inBlacklist, err := IsTokenInBlacklist(ctx, tokenID)
+		if err != nil {
+			return false, err
+		}
+		if inBlacklist {
+			return true, nil
+		}
+	}
+
+	revokeTime, err := GetUserTokensRevokeTime(ctx, userID, sessionID)
+	if err != nil {
+		return false, err
+	}
+	return IssuedBeforeRevoke(issuedAtMilli, revokeTime), nil
+}
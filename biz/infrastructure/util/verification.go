@@ -26,6 +26,12 @@ func GetCodeRedisKey(identifier string) string {
 	return consts.CodeRedisPrefix + identifier
 }
 
+// GetPurposeCodeRedisKey 获取指定用途验证码在Redis中的键
+// 冷却、失败次数和冻结仍按邮箱共享，不同用途不能绕过发送频率和冻结限制
+func GetPurposeCodeRedisKey(purpose, identifier string) string {
+	return consts.CodeRedisPrefix + purpose + ":" + identifier
+}
+
 // GetCodeCooldownKey 获取验证码冷却在Redis中的键
 func GetCodeCooldownKey(identifier string) string {
 	return consts.CodeCooldownPrefix + identifier
@@ -300,24 +306,6 @@ func ResetLoginFailIPCount(ctx context.Context, ip string) error {
 	return Del(ctx, key)
 }
 
-// GetTokenBlacklistKey 获取token黑名单在Redis中的键
-func GetTokenBlacklistKey(userID string) string {
-	return consts.TokenBlacklistPrefix + userID
-}
-
-// AddTokenToBlacklist 将token添加到黑名单
-func AddTokenToBlacklist(ctx context.Context, userID string) error {
-	key := GetTokenBlacklistKey(userID)
-	// 使用当前时间戳作为值，便于后续扩展
-	return SetWithExpire(ctx, key, time.Now().Unix(), time.Duration(consts.TokenBlacklistExpire)*time.Second)
-}
-
-// IsTokenInBlacklist 检查token是否在黑名单中
-func IsTokenInBlacklist(ctx context.Context, userID string) (bool, error) {
-	key := GetTokenBlacklistKey(userID)
-	return Exists(ctx, key)
-}
-
 // GetAccountFreezeRemainTime 获取账号冻结剩余时间（秒）
 func GetAccountFreezeRemainTime(ctx context.Context, identifier string) (int, error) {
 	// 获取账号冻结键
@@ -0,0 +1,263 @@
+package webauthn
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/passkey"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	gowebauthn "github.com/go-webauthn/webauthn/webauthn"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrCredentialNotFound 凭证不属于该用户
+var ErrCredentialNotFound = errors.New("credential not found")
+
+// User 通行密钥仪式中的用户，实现webauthn.User接口
+// user handle使用用户ID的12字节，不包含邮箱等个人信息
+type User struct {
+	ID       primitive.ObjectID
+	Name     string // 登录标识，邮箱或没有邮箱时的手机号
+	Passkeys []*passkey.Passkey
+}
+
+// 确保User实现了webauthn.User接口
+var _ gowebauthn.User = (*User)(nil)
+
+// WebAuthnID 用户handle
+func (u *User) WebAuthnID() []byte {
+	return u.ID[:]
+}
+
+// WebAuthnName 验证器中显示的账号名
+func (u *User) WebAuthnName() string {
+	return u.Name
+}
+
+// WebAuthnDisplayName 验证器中显示的用户名称
+func (u *User) WebAuthnDisplayName() string {
+	return u.Name
+}
+
+// WebAuthnIcon 已从规范中移除，返回空字符串
+func (u *User) WebAuthnIcon() string {
+	return ""
+}
+
+// WebAuthnCredentials 用户已注册的通行密钥
+func (u *User) WebAuthnCredentials() []gowebauthn.Credential {
+	credentials := make([]gowebauthn.Credential, 0, len(u.Passkeys))
+	for _, item := range u.Passkeys {
+		credentials = append(credentials, toCredential(item))
+	}
+	return credentials
+}
+
+// LoginResult 登录仪式的校验结果
+type LoginResult struct {
+	User         *User
+	Passkey      *passkey.Passkey // 本次使用的通行密钥，签名计数和标志已更新为本次断言中的值
+	CloneWarning bool             // 签名计数未增长，验证器可能已被复制
+}
+
+var instance *gowebauthn.WebAuthn
+var instanceErr error
+var once sync.Once
+
+// getWebAuthn 获取WebAuthn单例
+// 只请求none证明，不校验验证器型号；要求可发现凭证，登录时无需先输入邮箱
+func getWebAuthn() (*gowebauthn.WebAuthn, error) {
+	once.Do(func() {
+		cfg := config.GetConfig().WebAuthn
+		timeout := time.Duration(consts.PasskeyCeremonyExpire) * time.Second
+		instance, instanceErr = gowebauthn.New(&gowebauthn.Config{
+			RPID:                  cfg.RPID,
+			RPDisplayName:         cfg.RPDisplayName,
+			RPOrigins:             cfg.RPOrigins,
+			AttestationPreference: protocol.PreferNoAttestation,
+			AuthenticatorSelection: protocol.AuthenticatorSelection{
+				RequireResidentKey: protocol.ResidentKeyRequired(),
+				ResidentKey:        protocol.ResidentKeyRequirementRequired,
+				UserVerification:   protocol.VerificationPreferred,
+			},
+			Timeouts: gowebauthn.TimeoutsConfig{
+				Login:        gowebauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout},
+				Registration: gowebauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout},
+			},
+		})
+	})
+	return instance, instanceErr
+}
+
+// BeginRegistration 开始注册仪式，返回传给navigator.credentials.create()的参数和需要暂存的会话数据
+// 用户已注册的通行密钥放入排除列表，同一验证器不能重复注册
+func BeginRegistration(u *User) (*protocol.CredentialCreation, string, error) {
+	w, err := getWebAuthn()
+	if err != nil {
+		return nil, "", err
+	}
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(u.Passkeys))
+	for _, credential := range u.WebAuthnCredentials() {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	creation, session, err := w.BeginRegistration(u, gowebauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, "", err
+	}
+	return creation, string(data), nil
+}
+
+// FinishRegistration 校验navigator.credentials.create()的结果，返回待保存的通行密钥
+// 会话数据中的用户必须与当前用户一致
+func FinishRegistration(u *User, sessionData string, body []byte) (*passkey.Passkey, error) {
+	w, err := getWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	var session gowebauthn.SessionData
+	if err = json.Unmarshal([]byte(sessionData), &session); err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := w.CreateCredential(u, session, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	return &passkey.Passkey{
+		UserID:          u.ID,
+		CredentialID:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		UserVerified:    credential.Flags.UserVerified,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+	}, nil
+}
+
+// BeginLogin 开始登录仪式，返回传给navigator.credentials.get()的参数和需要暂存的会话数据
+// 使用可发现凭证，不需要也不透露账号是否存在
+func BeginLogin() (*protocol.CredentialAssertion, string, error) {
+	w, err := getWebAuthn()
+	if err != nil {
+		return nil, "", err
+	}
+
+	assertion, session, err := w.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, "", err
+	}
+	return assertion, string(data), nil
+}
+
+// FinishLogin 校验navigator.credentials.get()的结果
+// findUser根据断言中的user handle加载用户及其通行密钥
+func FinishLogin(sessionData string, body []byte, findUser func(userID primitive.ObjectID) (*User, error)) (*LoginResult, error) {
+	w, err := getWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	var session gowebauthn.SessionData
+	if err = json.Unmarshal([]byte(sessionData), &session); err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var found *User
+	handler := func(rawID, userHandle []byte) (gowebauthn.User, error) {
+		if len(userHandle) != len(primitive.ObjectID{}) {
+			return nil, ErrCredentialNotFound
+		}
+		var userID primitive.ObjectID
+		copy(userID[:], userHandle)
+
+		u, err := findUser(userID)
+		if err != nil {
+			return nil, err
+		}
+		found = u
+		return u, nil
+	}
+
+	credential, err := w.ValidateDiscoverableLogin(handler, session, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	// 找到本次使用的通行密钥，记录断言中的签名计数和标志
+	for _, item := range found.Passkeys {
+		if !bytes.Equal(item.CredentialID, credential.ID) {
+			continue
+		}
+		used := *item
+		used.SignCount = credential.Authenticator.SignCount
+		used.UserVerified = credential.Flags.UserVerified
+		used.BackupState = credential.Flags.BackupState
+		return &LoginResult{
+			User:         found,
+			Passkey:      &used,
+			CloneWarning: credential.Authenticator.CloneWarning,
+		}, nil
+	}
+	return nil, ErrCredentialNotFound
+}
+
+// toCredential 转换为webauthn库的凭证
+func toCredential(item *passkey.Passkey) gowebauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, 0, len(item.Transports))
+	for _, transport := range item.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(transport))
+	}
+
+	return gowebauthn.Credential{
+		ID:              item.CredentialID,
+		PublicKey:       item.PublicKey,
+		AttestationType: item.AttestationType,
+		Transport:       transports,
+		Flags: gowebauthn.CredentialFlags{
+			UserVerified:   item.UserVerified,
+			BackupEligible: item.BackupEligible,
+			BackupState:    item.BackupState,
+		},
+		Authenticator: gowebauthn.Authenticator{
+			AAGUID:    item.AAGUID,
+			SignCount: item.SignCount,
+		},
+	}
+}
@@ -0,0 +1,379 @@
+package webauthn
+
+import (
+	"auth/biz/infrastructure/config"
+	"auth/biz/infrastructure/consts"
+	"auth/biz/infrastructure/mapper/passkey"
+	"auth/biz/infrastructure/util"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 验证器数据中的标志位（WebAuthn 6.1）
+const (
+	flagUserPresent  byte = 0x01
+	flagUserVerified byte = 0x04
+	flagAttestedData byte = 0x40
+)
+
+// TestMain 使用内存Redis保存仪式数据
+func TestMain(m *testing.M) {
+	server, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer server.Close()
+
+	port, _ := strconv.Atoi(server.Port())
+	config.GetConfig().Redis = config.RedisConfig{Host: server.Host(), Port: port}
+	os.Exit(m.Run())
+}
+
+// softAuthenticator 软件实现的验证器，使用P-256密钥，只生成none证明
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+	origin       string
+}
+
+// newSoftAuthenticator 创建软件验证器
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialID := make([]byte, 16)
+	if _, err = rand.Read(credentialID); err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{
+		key:          key,
+		credentialID: credentialID,
+		origin:       config.GetConfig().WebAuthn.RPOrigins[0],
+	}
+}
+
+// create 模拟navigator.credentials.create()，返回提交给服务端的JSON
+func (a *softAuthenticator) create(t *testing.T, options *protocol.CredentialCreation) []byte {
+	t.Helper()
+	a.userHandle = options.Response.User.ID.(protocol.URLEncodedBase64)
+
+	clientData := a.clientData(t, protocol.CreateCeremony, options.Response.Challenge)
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authData := a.authenticatorData(options.Response.RelyingParty.ID, flagUserPresent|flagUserVerified|flagAttestedData)
+	authData = append(authData, make([]byte, 16)...) // AAGUID，none证明时全为0
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, publicKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": authData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.marshal(t, map[string]interface{}{
+		"clientDataJSON":    encode(clientData),
+		"attestationObject": encode(attestation),
+	})
+}
+
+// get 模拟navigator.credentials.get()，签名计数加一后签名
+func (a *softAuthenticator) get(t *testing.T, options *protocol.CredentialAssertion) []byte {
+	t.Helper()
+	a.signCount++
+	return a.sign(t, options)
+}
+
+// sign 使用当前签名计数对断言签名，计数不变时模拟被复制的验证器
+func (a *softAuthenticator) sign(t *testing.T, options *protocol.CredentialAssertion) []byte {
+	t.Helper()
+	clientData := a.clientData(t, protocol.AssertCeremony, options.Response.Challenge)
+	authData := a.authenticatorData(options.Response.RelyingPartyID, flagUserPresent|flagUserVerified)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.marshal(t, map[string]interface{}{
+		"clientDataJSON":    encode(clientData),
+		"authenticatorData": encode(authData),
+		"signature":         encode(signature),
+		"userHandle":        encode(a.userHandle),
+	})
+}
+
+// clientData 浏览器生成的CollectedClientData
+func (a *softAuthenticator) clientData(t *testing.T, ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]string{
+		"type":      string(ceremony),
+		"challenge": encode(challenge),
+		"origin":    a.origin,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// authenticatorData RP ID摘要、标志和签名计数
+func (a *softAuthenticator) authenticatorData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+// marshal 构造PublicKeyCredential
+func (a *softAuthenticator) marshal(t *testing.T, response map[string]interface{}) []byte {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{
+		"id":       encode(a.credentialID),
+		"rawId":    encode(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+// encode base64url编码，不带填充
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// register 完成一次注册，返回保存的通行密钥
+func register(t *testing.T, u *User, authenticator *softAuthenticator) *passkey.Passkey {
+	t.Helper()
+	options, session, err := BeginRegistration(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := FinishRegistration(u, session, authenticator.create(t, options))
+	if err != nil {
+		t.Fatalf("注册失败: %v", err)
+	}
+	created.ID = primitive.NewObjectID()
+	u.Passkeys = append(u.Passkeys, created)
+	return created
+}
+
+func TestRegistrationWithNoneAttestation(t *testing.T) {
+	u := &User{ID: primitive.NewObjectID(), Name: "user@example.com"}
+	authenticator := newSoftAuthenticator(t)
+
+	options, _, err := BeginRegistration(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.Response.Attestation != protocol.PreferNoAttestation {
+		t.Errorf("attestation = %q, want none", options.Response.Attestation)
+	}
+	if !*options.Response.AuthenticatorSelection.RequireResidentKey {
+		t.Error("应要求可发现凭证")
+	}
+
+	created := register(t, u, authenticator)
+	if created.AttestationType != "none" {
+		t.Errorf("AttestationType = %q, want none", created.AttestationType)
+	}
+	if created.UserID != u.ID || string(created.CredentialID) != string(authenticator.credentialID) {
+		t.Error("通行密钥的用户或凭证ID不正确")
+	}
+	if !created.UserVerified {
+		t.Error("应记录用户验证标志")
+	}
+
+	// 已注册的验证器出现在排除列表中
+	options, _, err = BeginRegistration(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(options.Response.CredentialExcludeList) != 1 {
+		t.Errorf("排除列表长度 = %d, want 1", len(options.Response.CredentialExcludeList))
+	}
+}
+
+func TestRegistrationRejectsOtherUser(t *testing.T) {
+	owner := &User{ID: primitive.NewObjectID(), Name: "owner@example.com"}
+	other := &User{ID: primitive.NewObjectID(), Name: "other@example.com"}
+	authenticator := newSoftAuthenticator(t)
+
+	options, session, err := BeginRegistration(owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = FinishRegistration(other, session, authenticator.create(t, options)); err == nil {
+		t.Fatal("其他用户不能完成注册仪式")
+	}
+}
+
+func TestDiscoverableLogin(t *testing.T) {
+	u := &User{ID: primitive.NewObjectID(), Name: "user@example.com"}
+	authenticator := newSoftAuthenticator(t)
+	created := register(t, u, authenticator)
+
+	options, session, err := BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 可发现凭证登录不指定允许的凭证，不透露账号信息
+	if len(options.Response.AllowedCredentials) != 0 {
+		t.Error("登录参数不应包含凭证列表")
+	}
+
+	var requested primitive.ObjectID
+	result, err := FinishLogin(session, authenticator.get(t, options), func(userID primitive.ObjectID) (*User, error) {
+		requested = userID
+		return u, nil
+	})
+	if err != nil {
+		t.Fatalf("登录失败: %v", err)
+	}
+	if requested != u.ID {
+		t.Errorf("按user handle加载的用户 = %s, want %s", requested.Hex(), u.ID.Hex())
+	}
+	if result.User != u || result.Passkey.ID != created.ID {
+		t.Error("返回的用户或通行密钥不正确")
+	}
+	if result.CloneWarning || result.Passkey.SignCount != 1 {
+		t.Errorf("CloneWarning = %v, SignCount = %d", result.CloneWarning, result.Passkey.SignCount)
+	}
+}
+
+func TestLoginRejectsUnknownUser(t *testing.T) {
+	u := &User{ID: primitive.NewObjectID(), Name: "user@example.com"}
+	authenticator := newSoftAuthenticator(t)
+	register(t, u, authenticator)
+
+	options, session, err := BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = FinishLogin(session, authenticator.get(t, options), func(primitive.ObjectID) (*User, error) {
+		return nil, ErrCredentialNotFound
+	})
+	if err == nil {
+		t.Fatal("找不到用户时应登录失败")
+	}
+}
+
+func TestLoginDetectsClonedAuthenticator(t *testing.T) {
+	u := &User{ID: primitive.NewObjectID(), Name: "user@example.com"}
+	authenticator := newSoftAuthenticator(t)
+	register(t, u, authenticator)
+	findUser := func(primitive.ObjectID) (*User, error) { return u, nil }
+
+	options, session, err := BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := FinishLogin(session, authenticator.get(t, options), findUser)
+	if err != nil || result.CloneWarning {
+		t.Fatalf("首次登录: err = %v, CloneWarning = %v", err, result != nil && result.CloneWarning)
+	}
+	// 保存本次登录的签名计数
+	u.Passkeys[0] = result.Passkey
+
+	// 被复制的验证器使用相同的签名计数
+	options, session, err = BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = FinishLogin(session, authenticator.sign(t, options), findUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.CloneWarning {
+		t.Fatal("签名计数未增长时应报告CloneWarning")
+	}
+	if result.Passkey.SignCount != 1 {
+		t.Errorf("SignCount = %d, 不应更新为断言中的计数", result.Passkey.SignCount)
+	}
+}
+
+func TestCeremonyIsOneTime(t *testing.T) {
+	ctx := context.Background()
+	u := &User{ID: primitive.NewObjectID(), Name: "user@example.com"}
+	authenticator := newSoftAuthenticator(t)
+	register(t, u, authenticator)
+	findUser := func(primitive.ObjectID) (*User, error) { return u, nil }
+
+	options, session, err := BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ceremonyID, err := util.SavePasskeyCeremony(ctx, consts.PasskeyCeremonyLogin, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := authenticator.get(t, options)
+
+	stored, err := util.ConsumePasskeyCeremony(ctx, consts.PasskeyCeremonyLogin, ceremonyID)
+	if err != nil || stored == "" {
+		t.Fatalf("取出仪式失败: %v", err)
+	}
+	if _, err = FinishLogin(stored, body, findUser); err != nil {
+		t.Fatal(err)
+	}
+
+	// 同一仪式不能再次取出，重放的断言没有可用的challenge
+	stored, err = util.ConsumePasskeyCeremony(ctx, consts.PasskeyCeremonyLogin, ceremonyID)
+	if err != nil || stored != "" {
+		t.Fatalf("仪式应只能使用一次: stored = %q, err = %v", stored, err)
+	}
+
+	// 断言与challenge绑定，不能用于另一个仪式
+	_, otherSession, err := BeginLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = FinishLogin(otherSession, body, findUser); err == nil {
+		t.Fatal("断言不能用于其他仪式")
+	}
+
+	// 注册仪式与登录仪式分开存储
+	if stored, _ = util.ConsumePasskeyCeremony(ctx, consts.PasskeyCeremonyRegister, ceremonyID); stored != "" {
+		t.Fatal("登录仪式不能作为注册仪式取出")
+	}
+}
@@ -4,12 +4,24 @@ package main
 
 import (
 	handler "auth/biz/adaptor/controller"
+	"auth/biz/adaptor/middleware"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
 // customizeRegister registers customize routers.
 func customizedRegister(r *server.Hertz) {
 	r.GET("/ping", handler.Ping)
+	r.GET("/.well-known/jwks.json", handler.JWKS)
+	r.GET("/.well-known/openid-configuration", handler.OpenIDConfiguration)
+
+	// OAuth 2.0授权服务
+	r.GET("/oauth/authorize", handler.OAuthAuthorize)
+	r.POST("/oauth/authorize", handler.OAuthAuthorizeSubmit)
+	r.POST("/oauth/token", handler.OAuthToken)
+
+	// OpenID Connect用户信息端点
+	r.GET("/userinfo", middleware.JWTAuth(), handler.UserInfo)
+	r.POST("/userinfo", middleware.JWTAuth(), handler.UserInfo)
 
 	// your code ...
 }